@@ -2,4 +2,9 @@ module germandv.xyz
 
 go 1.21
 
-require github.com/russross/blackfriday/v2 v2.1.0
+require (
+	github.com/BurntSushi/toml v1.5.0
+	github.com/russross/blackfriday/v2 v2.1.0
+	golang.org/x/image v0.18.0
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,212 @@
+package checker
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"germandv.xyz/internal/editor"
+	"germandv.xyz/internal/entry"
+	"germandv.xyz/internal/filer"
+)
+
+// Problem is an issue found in the front matter of an entry.
+type Problem struct {
+	File string
+	Line int
+	Msg  string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s:%d: %s", p.File, p.Line, p.Msg)
+}
+
+var required = []string{"published", "revision", "title", "excerpt"}
+
+// result is the outcome of checking a single entry.
+type result struct {
+	problems []Problem
+	slug     string
+	slugLine int
+}
+
+// Run validates the front matter of all draft and published entries.
+func Run() ([]Problem, error) {
+	drafts, err := filer.ListDrafts()
+	if err != nil {
+		return nil, err
+	}
+	published, err := filer.ListPublished()
+	if err != nil {
+		return nil, err
+	}
+	logos, err := filer.ListLogos()
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{}
+	for _, list := range []map[uint]string{drafts, published} {
+		for _, file := range list {
+			files = append(files, file)
+		}
+	}
+	sort.Strings(files)
+
+	problems := []Problem{}
+	slugs := make(map[string]string)
+
+	for _, file := range files {
+		res, err := checkEntry(file, logos)
+		if err != nil {
+			return nil, err
+		}
+		problems = append(problems, res.problems...)
+
+		if res.slug == "" {
+			continue
+		}
+		if other, ok := slugs[res.slug]; ok {
+			problems = append(problems, Problem{
+				File: file,
+				Line: res.slugLine,
+				Msg:  fmt.Sprintf("duplicate slug %q, already used by %s", res.slug, other),
+			})
+		} else {
+			slugs[res.slug] = file
+		}
+	}
+
+	return problems, nil
+}
+
+// checkEntry validates a single entry.
+func checkEntry(file string, logos map[string]bool) (*result, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(string(content), "\n")
+
+	problems := []Problem{}
+	report := func(key string, msg string, args ...any) {
+		problems = append(problems, Problem{
+			File: file,
+			Line: keyLine(lines, key),
+			Msg:  fmt.Sprintf(msg, args...),
+		})
+	}
+
+	fm, _, err := editor.ParseMd(file)
+	if err != nil {
+		line, msg := syntaxError(lines, err)
+		problems = append(problems, Problem{File: file, Line: line, Msg: "invalid front matter: " + msg})
+		return &result{problems: problems}, nil
+	}
+
+	for _, key := range required {
+		if !fm.Has(key) {
+			report("", "missing required field %q", key)
+		}
+	}
+
+	for _, key := range fm.Keys() {
+		if !entry.IsKnownKey(key) {
+			report(key, "unknown field %q", key)
+		}
+	}
+
+	var publishedAt, revisedAt time.Time
+	if fm.Has("published") {
+		publishedAt, err = time.Parse(entry.InputDateFormat, fm.Published)
+		if err != nil {
+			report("published", "published date %q is not in %s format", fm.Published, entry.InputDateFormat)
+		}
+	}
+	if fm.Has("revision") {
+		revisedAt, err = time.Parse(entry.InputDateFormat, fm.Revision)
+		if err != nil {
+			report("revision", "revision date %q is not in %s format", fm.Revision, entry.InputDateFormat)
+		}
+	}
+	if !publishedAt.IsZero() && !revisedAt.IsZero() && revisedAt.Before(publishedAt) {
+		report("revision", "revision date %s is earlier than published date %s", fm.Revision, fm.Published)
+	}
+
+	if fm.Has("slug") && fm.Slug != entry.Slugify(fm.Slug) {
+		report("slug", "slug %q is not URL safe, try %q", fm.Slug, entry.Slugify(fm.Slug))
+	}
+
+	for _, tag := range fm.Tags {
+		if strings.ToLower(tag) != entry.Slugify(tag) {
+			report("tags", "tag %q is not URL safe, try %q", tag, entry.Slugify(tag))
+		}
+		if !logos[tag] {
+			report("tags", "tag %q has no logo in assets/logos", tag)
+		}
+	}
+
+	res := &result{problems: problems, slug: fm.Slug, slugLine: keyLine(lines, "slug")}
+	if res.slug == "" {
+		res.slug = fm.Title
+		res.slugLine = keyLine(lines, "title")
+	}
+	if entry.IsReservedSlug(res.slug) {
+		res.problems = append(res.problems, Problem{
+			File: file,
+			Line: res.slugLine,
+			Msg:  fmt.Sprintf("slug %q is reserved for the site", res.slug),
+		})
+	}
+
+	return res, nil
+}
+
+// keyLine returns the line number where `key` is defined in the front matter,
+// it understands YAML (`key:`), TOML (`key =`) and JSON (`"key":`).
+// It falls back to the first line when the key is not found.
+func keyLine(lines []string, key string) int {
+	if key == "" {
+		return 1
+	}
+
+	pattern := regexp.MustCompile(`^\s*"?` + regexp.QuoteMeta(key) + `"?\s*[:=]`)
+	for i, line := range lines {
+		if pattern.MatchString(line) {
+			return i + 1
+		}
+	}
+
+	return 1
+}
+
+// parserLine matches the line in the errors of the YAML and TOML parsers,
+// counted from the line after the opening delimiter.
+var parserLine = regexp.MustCompile(`\bline (\d+)`)
+
+// syntaxError returns the line of the file where parsing the front matter failed,
+// and the error message with that line. It falls back to the first line
+// when the error has no line.
+func syntaxError(lines []string, err error) (int, string) {
+	msg := err.Error()
+	m := parserLine.FindStringSubmatchIndex(msg)
+	if m == nil {
+		return 1, msg
+	}
+
+	offset := 0
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			offset = i + 1
+			break
+		}
+	}
+
+	var n int
+	fmt.Sscan(msg[m[2]:m[3]], &n)
+	line := offset + n
+	return line, msg[:m[2]] + fmt.Sprint(line) + msg[m[3]:]
+}
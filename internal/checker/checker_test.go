@@ -0,0 +1,144 @@
+package checker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeEntry(t *testing.T, content string) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "entry.md")
+	err := os.WriteFile(file, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Error writing entry: %s", err)
+	}
+	return file
+}
+
+func TestCheckEntry(t *testing.T) {
+	t.Parallel()
+
+	logos := map[string]bool{"go": true}
+
+	tests := []struct {
+		name    string
+		content string
+		want    []Problem
+	}{
+		{
+			name: "valid",
+			content: `---
+title: a-title
+published: 2022-10-18
+revision: 2022-10-24
+tags: go
+excerpt: An excerpt.
+---`,
+			want: []Problem{},
+		},
+		{
+			name: "missing fields",
+			content: `---
+title: a-title
+published: 2022-10-18
+---`,
+			want: []Problem{
+				{Line: 1, Msg: `missing required field "revision"`},
+				{Line: 1, Msg: `missing required field "excerpt"`},
+			},
+		},
+		{
+			name: "bad dates, unknown key and missing logo",
+			content: `---
+title: a-title
+published: 2022-10-18
+revision: 2022-10-01
+exerpt: Typo.
+excerpt: An excerpt.
+tags: [go, cobol]
+---`,
+			want: []Problem{
+				{Line: 5, Msg: `unknown field "exerpt"`},
+				{Line: 4, Msg: "revision date 2022-10-01 is earlier than published date 2022-10-18"},
+				{Line: 7, Msg: `tag "cobol" has no logo in assets/logos`},
+			},
+		},
+		{
+			name: "toml with bad date format and slug",
+			content: `+++
+title = "A title"
+slug = "A title"
+published = "18/10/2022"
+revision = 2022-10-24
+excerpt = "An excerpt."
++++`,
+			want: []Problem{
+				{Line: 4, Msg: `published date "18/10/2022" is not in 2006-01-02 format`},
+				{Line: 3, Msg: `slug "A title" is not URL safe, try "a-title"`},
+			},
+		},
+		{
+			name: "reserved slug",
+			content: `---
+title: Images
+slug: img
+published: 2022-10-18
+revision: 2022-10-24
+excerpt: An excerpt.
+---`,
+			want: []Problem{
+				{Line: 3, Msg: `slug "img" is reserved for the site`},
+			},
+		},
+		{
+			name: "yaml syntax error",
+			content: `---
+title: a-title
+published: 2022-10-18
+  revision: 2022-10-24
+---`,
+			want: []Problem{
+				{Line: 4, Msg: "invalid front matter: yaml: line 4: mapping values are not allowed in this context"},
+			},
+		},
+		{
+			name: "toml syntax error",
+			content: `+++
+title = "a-title"
+published = 2022-10-18
+excerpt =
++++`,
+			want: []Problem{
+				{Line: 4, Msg: `invalid front matter: toml: line 4 (last key "excerpt"): unexpected EOF; expected value`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			file := writeEntry(t, tt.content)
+			res, err := checkEntry(file, logos)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if len(res.problems) != len(tt.want) {
+				t.Fatalf("want %d problems, got %d: %v", len(tt.want), len(res.problems), res.problems)
+			}
+			for i, want := range tt.want {
+				got := res.problems[i]
+				if got.File != file {
+					t.Errorf("want file %q, got %q", file, got.File)
+				}
+				if got.Line != want.Line || got.Msg != want.Msg {
+					t.Errorf("want %d: %s, got %d: %s", want.Line, want.Msg, got.Line, got.Msg)
+				}
+			}
+		})
+	}
+}
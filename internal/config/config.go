@@ -0,0 +1,140 @@
+package config
+
+import (
+	"errors"
+	"io/fs"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Site holds the metadata of the site and where its files live.
+type Site struct {
+	Title       string `toml:"title"`
+	BaseURL     string `toml:"base_url"`
+	Description string `toml:"description"`
+	// FeedDescription describes the feeds, Description is used when empty.
+	FeedDescription string   `toml:"feed_description"`
+	Language        string   `toml:"language"`
+	Author          string   `toml:"author"`
+	Keywords        []string `toml:"keywords"`
+	// Image is shared on social media for entries without a cover.
+	Image string `toml:"image"`
+	// BlogPath is the URL prefix of published entries, e.g. `/blog/`.
+	BlogPath   string `toml:"blog_path"`
+	EntriesDir string `toml:"entries_dir"`
+	DocsDir    string `toml:"docs_dir"`
+}
+
+// Default returns the configuration used when there is no config file.
+func Default() *Site {
+	return &Site{
+		Title:           "germandv",
+		BaseURL:         "https://germandv.me",
+		Description:     "Programming things, mostly web related.",
+		FeedDescription: "Programming things",
+		Language:        "en-us",
+		Author:          "germandv",
+		Keywords:        []string{"programming", "development", "go", "rust", "typescript", "javascript", "react", "fullstack"},
+		BlogPath:        "/blog/",
+		EntriesDir:      "entries",
+		DocsDir:         "docs",
+	}
+}
+
+// Load reads a TOML config file, fields not present keep their default value.
+// A missing file is not an error, the defaults are used instead.
+func Load(path string) (*Site, error) {
+	site := Default()
+
+	_, err := toml.DecodeFile(path, site)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	site.normalize()
+	return site, nil
+}
+
+// normalize makes sure URLs can be safely concatenated:
+// no trailing slash in the base URL and slashes around the blog path.
+func (s *Site) normalize() {
+	s.BaseURL = strings.TrimSuffix(s.BaseURL, "/")
+	s.BlogPath = "/" + strings.Trim(s.BlogPath, "/") + "/"
+	if s.BlogPath == "//" {
+		s.BlogPath = "/"
+	}
+}
+
+// Override replaces the fields set in `other`, used for CLI flags.
+func (s *Site) Override(other Site) {
+	if other.Title != "" {
+		s.Title = other.Title
+	}
+	if other.BaseURL != "" {
+		s.BaseURL = other.BaseURL
+	}
+	if other.Description != "" {
+		s.Description = other.Description
+	}
+	if other.Language != "" {
+		s.Language = other.Language
+	}
+	if other.Image != "" {
+		s.Image = other.Image
+	}
+	if other.Author != "" {
+		s.Author = other.Author
+	}
+	if other.BlogPath != "" {
+		s.BlogPath = other.BlogPath
+	}
+	if other.EntriesDir != "" {
+		s.EntriesDir = other.EntriesDir
+	}
+	if other.DocsDir != "" {
+		s.DocsDir = other.DocsDir
+	}
+	s.normalize()
+}
+
+// URL returns the absolute URL of `path`, which must start with "/".
+func (s *Site) URL(path string) string {
+	return s.BaseURL + path
+}
+
+// AbsURL resolves a reference to an absolute URL: absolute URLs are kept,
+// paths starting with "/" are relative to the site and anything else
+// to the blog path. An empty reference stays empty.
+func (s *Site) AbsURL(ref string) string {
+	switch {
+	case ref == "":
+		return ""
+	case strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://"):
+		return ref
+	case strings.HasPrefix(ref, "/"):
+		return s.URL(ref)
+	default:
+		return s.URL(s.BlogPath + ref)
+	}
+}
+
+// PostPath returns the path of the page of an entry, e.g. `/blog/slug.html`.
+func (s *Site) PostPath(slug string) string {
+	return s.BlogPath + slug + ".html"
+}
+
+// PostURL returns the absolute URL of the page of an entry.
+func (s *Site) PostURL(slug string) string {
+	return s.URL(s.PostPath(slug))
+}
+
+// BlogDir returns the directory inside DocsDir where pages are published.
+func (s *Site) BlogDir() string {
+	return strings.Trim(s.BlogPath, "/")
+}
+
+// KeywordList returns the keywords as a comma separated list, for meta tags.
+func (s *Site) KeywordList() string {
+	return strings.Join(s.Keywords, ", ")
+}
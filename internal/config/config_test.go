@@ -0,0 +1,95 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadWithoutFileUsesDefaults(t *testing.T) {
+	t.Parallel()
+
+	site, err := Load(filepath.Join(t.TempDir(), "missing.toml"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if got := site.PostURL("go-threadpool"); got != "https://germandv.me/blog/go-threadpool.html" {
+		t.Errorf("want default post URL, got %q", got)
+	}
+}
+
+func TestLoadOverridesDefaults(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "site.toml")
+	content := `
+title = "my blog"
+base_url = "https://example.com/"
+blog_path = "posts"
+`
+	err := os.WriteFile(file, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Error writing config: %s", err)
+	}
+
+	site, err := Load(file)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if site.Title != "my blog" {
+		t.Errorf("want title %q, got %q", "my blog", site.Title)
+	}
+	if site.Language != "en-us" {
+		t.Errorf("want default language, got %q", site.Language)
+	}
+	if got := site.PostURL("a-post"); got != "https://example.com/posts/a-post.html" {
+		t.Errorf("want normalized post URL, got %q", got)
+	}
+	if site.BlogDir() != "posts" {
+		t.Errorf("want blog dir %q, got %q", "posts", site.BlogDir())
+	}
+
+	site.Override(Site{BaseURL: "http://localhost:4000/", DocsDir: "public"})
+	if got := site.PostURL("a-post"); got != "http://localhost:4000/posts/a-post.html" {
+		t.Errorf("want overridden post URL, got %q", got)
+	}
+	if site.DocsDir != "public" || site.Title != "my blog" {
+		t.Errorf("want only set fields overridden, got %+v", site)
+	}
+}
+
+func TestLoadInvalidFile(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "site.toml")
+	err := os.WriteFile(file, []byte("title = "), 0644)
+	if err != nil {
+		t.Fatalf("Error writing config: %s", err)
+	}
+
+	_, err = Load(file)
+	if err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestAbsURL(t *testing.T) {
+	t.Parallel()
+
+	site := Default()
+
+	tests := map[string]string{
+		"":                              "",
+		"https://cdn.example.com/a.png": "https://cdn.example.com/a.png",
+		"/assets/cover.png":             "https://germandv.me/assets/cover.png",
+		"img/cover.png":                 "https://germandv.me/blog/img/cover.png",
+	}
+
+	for ref, want := range tests {
+		if got := site.AbsURL(ref); got != want {
+			t.Errorf("AbsURL(%q): want %q, got %q", ref, want, got)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package editor
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"germandv.xyz/internal/filer"
+)
+
+var linkAttr = regexp.MustCompile(`\s(href|src)="([^"]*)"`)
+
+// BundleLinks resolves the relative links of a page bundle entry
+// to its assets, served from `blog/<slug>/`.
+// The HTML of entries that are not bundles is returned unchanged.
+func BundleLinks(body template.HTML, entryfile string, slug string) template.HTML {
+	if !filer.IsBundle(entryfile) {
+		return body
+	}
+
+	out := linkAttr.ReplaceAllStringFunc(string(body), func(attr string) string {
+		m := linkAttr.FindStringSubmatch(attr)
+		ref, err := url.Parse(html.UnescapeString(m[2]))
+		if err != nil || ref.Scheme != "" || ref.Host != "" || ref.Path == "" || strings.HasPrefix(ref.Path, "/") {
+			return attr
+		}
+		return fmt.Sprintf(` %s="%s"`, m[1], site.BlogPath+slug+"/"+strings.TrimPrefix(m[2], "./"))
+	})
+
+	return template.HTML(out)
+}
@@ -0,0 +1,37 @@
+package editor
+
+import (
+	"html/template"
+	"testing"
+)
+
+func TestBundleLinks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"short href", `<a href="e">e</a>`, `<a href="/blog/b/e">e</a>`},
+		{"short src", `<img src="c" alt="" />`, `<img src="/blog/b/c" alt="" />`},
+		{"target in attribute name", `<a href="ref">ref</a>`, `<a href="/blog/b/ref">ref</a>`},
+		{"dot slash", `<a href="./data/points.txt">data</a>`, `<a href="/blog/b/data/points.txt">data</a>`},
+		{"absolute and fragments", `<a href="/index.html">home</a> <a href="#top">top</a> <a href="https://go.dev">go</a>`,
+			`<a href="/index.html">home</a> <a href="#top">top</a> <a href="https://go.dev">go</a>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BundleLinks(template.HTML(tt.body), "entries/published/b/index.md", "b")
+			if string(got) != tt.want {
+				t.Errorf("want %s, got %s", tt.want, got)
+			}
+		})
+	}
+
+	body := template.HTML(`<a href="e">e</a>`)
+	if got := BundleLinks(body, "entries/published/b.md", "b"); got != body {
+		t.Errorf("want entries that are not bundles unchanged, got %s", got)
+	}
+}
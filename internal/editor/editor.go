@@ -3,51 +3,23 @@ package editor
 import (
 	"bufio"
 	"errors"
+	"fmt"
 	"html/template"
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	texttemplate "text/template"
 	"time"
 
+	"germandv.xyz/internal/config"
 	"germandv.xyz/internal/entry"
 	"germandv.xyz/internal/filer"
 	"github.com/russross/blackfriday/v2"
 )
 
-func readFrontMatter(scanner *bufio.Scanner) (map[string]string, error) {
-	frontMatter := make(map[string]string)
-	openingDelimiterSeen := false
-
-	for scanner.Scan() {
-		line := strings.Trim(scanner.Text(), " ")
-
-		if line == "---" {
-			if openingDelimiterSeen {
-				// End of the front matter
-				return frontMatter, nil
-			} else {
-				// Beginning of the front matter
-				openingDelimiterSeen = true
-			}
-		} else if openingDelimiterSeen {
-			keyvalue := strings.SplitN(line, ":", 2)
-			if len(keyvalue) != 2 {
-				return nil, errors.New("invalid front matter key-value pair")
-			}
-			frontMatter[keyvalue[0]] = strings.Trim(keyvalue[1], " ")
-		}
-	}
-
-	err := scanner.Err()
-	if err != nil {
-		return nil, err
-	}
-
-	return nil, errors.New("no content found")
-}
-
 func readBody(scanner *bufio.Scanner) ([]byte, error) {
 	body := []byte{}
 	for scanner.Scan() {
@@ -63,7 +35,7 @@ func readBody(scanner *bufio.Scanner) ([]byte, error) {
 	return body, nil
 }
 
-func ParseMd(fp string) (map[string]string, []byte, error) {
+func ParseMd(fp string) (*entry.FrontMatter, []byte, error) {
 	f, err := os.Open(fp)
 	if err != nil {
 		return nil, nil, err
@@ -85,17 +57,60 @@ func ParseMd(fp string) (map[string]string, []byte, error) {
 	return frontMatter, body, nil
 }
 
+// site is the configuration exposed to templates as `site`.
+var site = config.Default()
+
+// Configure sets the site configuration used when rendering pages.
+func Configure(s *config.Site) {
+	site = s
+}
+
+// parseTemplates parses the given files from `templates/`,
+// making the site configuration available as `site`.
+func parseTemplates(files ...string) (*template.Template, error) {
+	paths := make([]string, 0, len(files))
+	for _, file := range files {
+		paths = append(paths, filepath.Join("templates", file))
+	}
+
+	return template.New(files[0]).Funcs(template.FuncMap{
+		"site": func() *config.Site { return site },
+	}).ParseFiles(paths...)
+}
+
+// ToHTML converts the markdown body of `entryfile` to HTML,
+// expanding shortcodes and highlighting the code blocks.
+func ToHTML(body []byte, entryfile string) (template.HTML, error) {
+	html, _, err := convert(body, entryfile)
+	return html, err
+}
+
+// convert is like ToHTML, also returning the headings found in the body.
+func convert(body []byte, entryfile string) (template.HTML, []*entry.Heading, error) {
+	r := newRenderer()
+	body, err := expandShortcodes(body, r, entryfile)
+	if err != nil {
+		return "", nil, err
+	}
+
+	html := string(blackfriday.Run(body, blackfriday.WithRenderer(r)))
+	return template.HTML(html), r.sortedHeadings(html), nil
+}
+
 type PageLink struct {
 	Link        string
 	Title       string
 	Date        time.Time
 	DateDisplay string
 	Tags        []string
+	WordCount   int
+	ReadingTime int
 }
 
-// GenerateIndex (re)creates the blog.html page listing all published entries.
+// GenerateIndex (re)creates the blog.html page listing all published entries,
+// and the tag pages listing the entries for each tag.
 func GenerateIndex() error {
-	files, err := filer.ListPages()
+	files, err := filer.ListPublished()
 	if err != nil {
 		return err
 	}
@@ -103,167 +118,456 @@ func GenerateIndex() error {
 	links := []PageLink{}
 
 	for _, file := range files {
-		title := strings.ReplaceAll(strings.TrimSuffix(file, ".html"), "-", " ")
-
-		// Read .md file to get front matter.
-		entryMd, err := filer.GetPublishedEntry(file)
+		frontMatter, body, err := ParseMd(file)
 		if err != nil {
 			return err
 		}
-		defer entryMd.Close()
-		scanner := bufio.NewScanner(entryMd)
-		frontMatter, err := readFrontMatter(scanner)
+
+		e, err := entry.NewHtmlEntry(frontMatter)
 		if err != nil {
-			return err
+			return fmt.Errorf("%s: %w", file, err)
 		}
 
-		date, err := time.Parse(entry.InputDateFormat, frontMatter["revision"])
+		content, err := ToHTML(body, file)
 		if err != nil {
-			return err
+			return fmt.Errorf("%s: %w", file, err)
 		}
-		dateDisplay, err := entry.FormatDate(frontMatter["revision"])
-		if err != nil {
-			return err
+		e.Measure(content)
+
+		// Entries without a slug keep their lowercase title in the index.
+		title := e.Title
+		if frontMatter.Slug == "" {
+			title = strings.ReplaceAll(e.Filename, "-", " ")
 		}
 
-		tags := strings.Split(frontMatter["tags"], ",")
-		if tags[0] == "" {
-			tags = []string{}
+		date, err := time.Parse(entry.InputDateFormat, frontMatter.Revision)
+		if err != nil {
+			return err
 		}
 
 		links = append(links, PageLink{
-			Link:        file,
+			Link:        site.PostPath(e.Filename),
 			Title:       title,
 			Date:        date,
-			DateDisplay: dateDisplay,
-			Tags:        tags,
+			DateDisplay: e.Revision,
+			Tags:        e.Tags,
+			WordCount:   e.WordCount,
+			ReadingTime: e.ReadingTime,
 		})
 	}
 
+	sort.Slice(links, func(i, j int) bool {
+		if !links[i].Date.Equal(links[j].Date) {
+			return links[i].Date.After(links[j].Date)
+		}
+		return links[i].Link < links[j].Link
+	})
+
 	indexWriter, err := filer.CreateIndex()
 	if err != nil {
 		return err
 	}
 	defer indexWriter.Close()
 
-	index := filepath.Join("templates", "blog.html")
-	footer := filepath.Join("templates", "footer.html")
-	tmpl, err := template.ParseFiles(index, footer)
+	tmpl, err := parseTemplates("blog.html", "post-list.html", "footer.html")
 	if err != nil {
 		return err
 	}
 
-	sort.Slice(links, func(i, j int) bool {
-		return links[i].Date.After(links[j].Date)
-	})
-
 	err = tmpl.ExecuteTemplate(indexWriter, "index", links)
 	if err != nil {
 		return err
 	}
 
+	return generateTagPages(links)
+}
+
+type TagPage struct {
+	Tag   string
+	Links []PageLink
+}
+
+// generateTagPages (re)creates one page per tag listing the entries with that tag.
+func generateTagPages(links []PageLink) error {
+	byTag := make(map[string][]PageLink)
+	for _, link := range links {
+		for _, tag := range link.Tags {
+			byTag[tag] = append(byTag[tag], link)
+		}
+	}
+
+	err := filer.ResetTagPages()
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := parseTemplates("tag.html", "post-list.html", "footer.html")
+	if err != nil {
+		return err
+	}
+
+	for tag, tagged := range byTag {
+		f, err := filer.CreateTagPage(tag)
+		if err != nil {
+			return err
+		}
+
+		err = tmpl.ExecuteTemplate(f, "tag", TagPage{Tag: tag, Links: tagged})
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 // Publish reads the .md file from `src`, converts it to .html and saves it in `dst`.
 // It also adds a link to the newly published entry to the index.
+// Scheduled drafts get their `publish_at` date, or today if it has not come yet,
+// as published and revision dates.
 func Publish(entryfile string) error {
-	frontMatter, body, err := ParseMd(entryfile)
+	frontMatter, _, err := ParseMd(entryfile)
 	if err != nil {
 		return err
 	}
 
-	entry, err := entry.NewHtmlEntry(frontMatter)
+	if !frontMatter.PublishAt.IsZero() {
+		date := frontMatter.PublishAt
+		if now := time.Now(); date.After(now) {
+			date = now
+		}
+		err = setPublishDate(entryfile, date)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = render(entryfile, pageOptions{})
 	if err != nil {
 		return err
 	}
 
-	entry.Body = template.HTML(blackfriday.Run(body))
+	err = filer.Publish(entryfile)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// pageOptions change how newPage prepares an entry.
+type pageOptions struct {
+	// preview leaves `docs/` untouched: bundle assets are not copied, images are not resized
+	// and relative links are kept, to be served from the directory of the entry.
+	preview bool
+	// images collects the names of the resized images used by the page, when not nil.
+	images map[string]bool
+}
+
+// render converts the .md file to .html and saves it in `dst`.
+// It returns the filename of the generated page.
+func render(entryfile string, opts pageOptions) (string, error) {
+	entry, err := newPage(entryfile, opts)
+	if err != nil {
+		return "", err
+	}
 
 	f, err := filer.CreatePage(entry.Filename)
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer f.Close()
 
-	layout := filepath.Join("templates", "layout.html")
-	footer := filepath.Join("templates", "footer.html")
-	tmpl, err := template.ParseFiles(layout, footer)
+	tmpl, err := parseTemplates("layout.html", "footer.html")
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	err = tmpl.ExecuteTemplate(f, "layout", entry)
+	if err != nil {
+		return "", err
+	}
+
+	return entry.Filename + ".html", nil
+}
+
+// newPage reads the .md file and returns the entry ready to be rendered.
+func newPage(entryfile string, opts pageOptions) (*entry.HtmlEntry, error) {
+	frontMatter, body, err := ParseMd(entryfile)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := entry.NewHtmlEntry(frontMatter)
+	if err != nil {
+		return nil, err
+	}
+
+	if filer.IsBundle(entryfile) && !opts.preview {
+		err = filer.CopyBundleAssets(entryfile, e.Filename)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var headings []*entry.Heading
+	e.Body, headings, err = convert(body, entryfile)
+	if err != nil {
+		return nil, err
+	}
+	e.Measure(e.Body)
+	if !opts.preview {
+		e.Body = BundleLinks(e.Body, entryfile, e.Filename)
+		e.Body, err = responsiveImages(e.Body, opts.images)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if frontMatter.TOC {
+		e.TOC = nestHeadings(headings)
+	}
+
+	err = addMeta(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Build re-renders every published entry, removes pages, bundle assets
+// and resized images no longer used by any entry and regenerates the index.
+func Build() error {
+	files, err := filer.ListPublished()
 	if err != nil {
 		return err
 	}
 
-	err = filer.Publish(entryfile)
+	rendered := make(map[string]bool)
+	images := make(map[string]bool)
+	for _, file := range files {
+		page, err := render(file, pageOptions{images: images})
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		rendered[page] = true
+	}
+
+	pages, err := filer.ListPages()
 	if err != nil {
 		return err
 	}
 
-	return nil
+	for _, page := range pages {
+		if !rendered[page] {
+			err = filer.RemovePage(page)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	dirs, err := filer.ListPageDirs()
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range dirs {
+		if !rendered[dir+".html"] {
+			err = filer.RemovePageDir(dir)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	names, err := filer.ListImages()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if !images[name] {
+			err = filer.RemoveImage(name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return GenerateIndex()
 }
 
 // PublishAll reads all .md files from `src`, converts them to .html and saves them in `dst`.
-func PublishAll() error {
+// Drafts scheduled with a `publish_at` time after `now` are left for later.
+// It returns the errors of the drafts that could not be published, by file.
+func PublishAll(now time.Time) error {
 	drafts, err := filer.ListDrafts()
 	if err != nil {
 		return err
 	}
 
+	var mu sync.Mutex
+	errs := []error{}
+	failed := func(draft string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, fmt.Errorf("%s: %w", draft, err))
+	}
+
 	var wg sync.WaitGroup
 	for _, draft := range drafts {
+		frontMatter, _, err := ParseMd(draft)
+		if err != nil {
+			failed(draft, err)
+			continue
+		}
+		if frontMatter.PublishAt.After(now) {
+			continue
+		}
+
 		wg.Add(1)
 		go func(waitgroup *sync.WaitGroup, draftname string) {
 			defer waitgroup.Done()
-			Publish(draftname)
+			err := Publish(draftname)
+			if err != nil {
+				failed(draftname, err)
+			}
 		}(&wg, draft)
 	}
 
 	wg.Wait()
-	return nil
+	return errors.Join(errs...)
+}
+
+// PublishDue publishes the drafts whose `publish_at` time is not after `now`.
+// Drafts that cannot be read or published are skipped, without holding back the others.
+// It returns the drafts that were published, and the errors of the skipped ones by file.
+func PublishDue(now time.Time) ([]string, error) {
+	drafts, err := filer.ListDrafts()
+	if err != nil {
+		return nil, err
+	}
+
+	errs := []error{}
+	due := []string{}
+	for _, draft := range drafts {
+		frontMatter, _, err := ParseMd(draft)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", draft, err))
+			continue
+		}
+		if frontMatter.PublishAt.IsZero() || frontMatter.PublishAt.After(now) {
+			continue
+		}
+		due = append(due, draft)
+	}
+	sort.Strings(due)
+
+	published := []string{}
+	for _, draft := range due {
+		err = Publish(draft)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", draft, err))
+			continue
+		}
+		published = append(published, draft)
+	}
+
+	return published, errors.Join(errs...)
 }
 
 // Draft creates a .md file in `src` and pre-populates the front matter.
-func Draft(title string) error {
-	f, err := filer.CreateDraft(title + ".md")
+// The filename is a slug derived from the title, unique among all entries.
+// It returns the name of the created file.
+func Draft(title string) (string, error) {
+	md := entry.NewMdEntry(title)
+	if md.Slug == "" {
+		return "", fmt.Errorf("cannot derive a slug from %q", title)
+	}
+
+	slug, err := uniqueSlug(md.Slug)
 	if err != nil {
-		return err
+		return "", err
 	}
-	defer f.Close()
+	md.Slug = slug
 
-	tpl, err := template.ParseFiles(filepath.Join("templates", "entry.md"))
+	tpl, err := texttemplate.New("entry.md").Funcs(texttemplate.FuncMap{
+		"quote": strconv.Quote,
+	}).ParseFiles(filepath.Join("templates", "entry.md"))
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	err = tpl.Execute(f, entry.NewMdEntry(title))
+	f, err := filer.CreateDraft(slug + ".md")
 	if err != nil {
-		return err
+		return "", err
 	}
+	defer f.Close()
 
-	return nil
+	err = tpl.Execute(f, md)
+	if err != nil {
+		return "", err
+	}
+
+	return slug + ".md", nil
 }
 
-// Preview reads a draft .md file and returns its HTML version,
-// without persisting anything to disk.
-func Preview(filename string) (*template.Template, *entry.HtmlEntry, error) {
-	frontMatter, body, err := ParseMd(filename)
+// uniqueSlug appends a numeric suffix to `slug` if it is already
+// taken by a draft, a published entry or a page.
+func uniqueSlug(slug string) (string, error) {
+	taken := make(map[string]bool)
+
+	drafts, err := filer.ListDrafts()
 	if err != nil {
-		return nil, nil, err
+		return "", err
+	}
+	published, err := filer.ListPublished()
+	if err != nil {
+		return "", err
+	}
+	for _, list := range []map[uint]string{drafts, published} {
+		for _, file := range list {
+			taken[filer.EntryName(file)] = true
+
+			// Pages are named after the slug in the front matter, if any.
+			frontMatter, _, err := ParseMd(file)
+			if err != nil {
+				return "", fmt.Errorf("%s: %w", file, err)
+			}
+			if frontMatter.Slug != "" {
+				taken[frontMatter.Slug] = true
+			}
+		}
+	}
+
+	pages, err := filer.ListPages()
+	if err != nil {
+		return "", err
+	}
+	for _, page := range pages {
+		taken[strings.TrimSuffix(page, ".html")] = true
 	}
 
-	entry, err := entry.NewHtmlEntry(frontMatter)
+	candidate := slug
+	for i := 2; taken[candidate] || entry.IsReservedSlug(candidate); i++ {
+		candidate = fmt.Sprintf("%s-%d", slug, i)
+	}
+
+	return candidate, nil
+}
+
+// Preview reads a draft .md file and returns its HTML version,
+// without writing anything to `docs/`. Relative links, like those
+// to the assets of a page bundle, point next to the .md file.
+func Preview(filename string) (*template.Template, *entry.HtmlEntry, error) {
+	entry, err := newPage(filename, pageOptions{preview: true})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	entry.Body = template.HTML(blackfriday.Run(body))
-	layout := filepath.Join("templates", "layout.html")
-	footer := filepath.Join("templates", "footer.html")
-	tmpl, err := template.ParseFiles(layout, footer)
+	tmpl, err := parseTemplates("layout.html", "footer.html")
 	if err != nil {
 		return nil, nil, err
 	}
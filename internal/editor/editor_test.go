@@ -0,0 +1,581 @@
+package editor
+
+import (
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"germandv.xyz/internal/testsite"
+)
+
+func TestMain(m *testing.M) {
+	testsite.Main(m, "../../")
+}
+
+func copyFile(t *testing.T, from, to string) {
+	t.Helper()
+
+	in, err := os.Open(from)
+	if err != nil {
+		t.Fatalf("Error opening %s: %s", from, err)
+	}
+	defer in.Close()
+
+	out, err := os.Create(to)
+	if err != nil {
+		t.Fatalf("Error creating %s: %s", to, err)
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	if err != nil {
+		t.Fatalf("Error copying %s: %s", from, err)
+	}
+}
+
+func TestBuildRendersPublishedEntriesAndRemovesOrphans(t *testing.T) {
+	published := "testdata/entries/published/example-post-one.md"
+	page := "testdata/docs/blog/example-post-one.html"
+	orphan := "testdata/docs/blog/orphan.html"
+	index := "testdata/docs/blog.html"
+
+	copyFile(t, "testdata/entries/example-post-one.md", published)
+	err := os.WriteFile(orphan, []byte("<html></html>"), 0644)
+	if err != nil {
+		t.Fatalf("Error creating orphan page: %s", err)
+	}
+	t.Cleanup(func() {
+		for _, f := range []string{published, page, orphan, index} {
+			os.Remove(f)
+		}
+		os.RemoveAll("testdata/docs/tags")
+	})
+
+	err = Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if _, err := os.Stat(page); err != nil {
+		t.Errorf("Expected %s to be rendered: %s", filepath.Base(page), err)
+	}
+	if _, err := os.Stat(index); err != nil {
+		t.Errorf("Expected index to be generated: %s", err)
+	}
+	if _, err := os.Stat(orphan); !os.IsNotExist(err) {
+		t.Errorf("Expected orphan page to be removed, got %v", err)
+	}
+}
+
+func TestDraftCreatesUniqueSlugs(t *testing.T) {
+	first, err := Draft("Hello, World!")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	t.Cleanup(func() { os.Remove(filepath.Join("testdata/entries/draft", first)) })
+
+	second, err := Draft("Hello World")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	t.Cleanup(func() { os.Remove(filepath.Join("testdata/entries/draft", second)) })
+
+	if first != "hello-world.md" {
+		t.Errorf("want %q, got %q", "hello-world.md", first)
+	}
+	if second != "hello-world-2.md" {
+		t.Errorf("want %q, got %q", "hello-world-2.md", second)
+	}
+
+	frontMatter, _, err := ParseMd(filepath.Join("testdata/entries/draft", first))
+	if err != nil {
+		t.Fatalf("Unexpected error parsing draft: %s", err)
+	}
+	if frontMatter.Title != "Hello, World!" {
+		t.Errorf("want title %q, got %q", "Hello, World!", frontMatter.Title)
+	}
+	if frontMatter.Slug != "hello-world" {
+		t.Errorf("want slug %q, got %q", "hello-world", frontMatter.Slug)
+	}
+}
+
+func TestDraftAvoidsReservedSlugs(t *testing.T) {
+	draft, err := Draft("img")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	t.Cleanup(func() { os.Remove(filepath.Join("testdata/entries/draft", draft)) })
+
+	if draft != "img-2.md" {
+		t.Errorf("want %q, got %q", "img-2.md", draft)
+	}
+}
+
+func TestDraftAvoidsSlugsFromFrontMatter(t *testing.T) {
+	other := "testdata/entries/draft/other.md"
+	err := os.WriteFile(other, []byte("---\ntitle: Other\nslug: hello-there\n---\n"), 0644)
+	if err != nil {
+		t.Fatalf("Error writing draft: %s", err)
+	}
+	t.Cleanup(func() { os.Remove(other) })
+
+	created, err := Draft("Hello There")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	t.Cleanup(func() { os.Remove(filepath.Join("testdata/entries/draft", created)) })
+
+	if created != "hello-there-2.md" {
+		t.Errorf("want %q, got %q", "hello-there-2.md", created)
+	}
+}
+
+func TestPublishDuePublishesOnlyDueDrafts(t *testing.T) {
+	drafts := map[string]string{
+		"due":    "2024-03-01T10:00:00Z",
+		"future": "2024-03-01T12:00:00Z",
+	}
+	for name, publishAt := range drafts {
+		content := fmt.Sprintf("---\ntitle: %s\npublished: 2024-02-01\nrevision: 2024-02-01\nexcerpt: Scheduled.\npublish_at: %s\n---\n\nBody.\n", name, publishAt)
+		err := os.WriteFile(filepath.Join("testdata/entries/draft", name+".md"), []byte(content), 0644)
+		if err != nil {
+			t.Fatalf("Error writing draft: %s", err)
+		}
+	}
+	t.Cleanup(func() {
+		os.Remove("testdata/entries/draft/future.md")
+		os.Remove("testdata/entries/published/due.md")
+		os.Remove("testdata/docs/blog/due.html")
+	})
+
+	now := time.Date(2024, 3, 1, 11, 0, 0, 0, time.UTC)
+	published, err := PublishDue(now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(published) != 1 || published[0] != "testdata/entries/draft/due.md" {
+		t.Errorf("want only due.md published, got %v", published)
+	}
+	if _, err := os.Stat("testdata/entries/published/due.md"); err != nil {
+		t.Errorf("Expected due.md to be moved to published: %s", err)
+	}
+	if _, err := os.Stat("testdata/entries/draft/future.md"); err != nil {
+		t.Errorf("Expected future.md to remain a draft: %s", err)
+	}
+
+	frontMatter, _, err := ParseMd("testdata/entries/published/due.md")
+	if err != nil {
+		t.Fatalf("Unexpected error parsing entry: %s", err)
+	}
+	if frontMatter.Published != "2024-03-01" || frontMatter.Revision != "2024-03-01" {
+		t.Errorf("want dates from publish_at, got %s and %s", frontMatter.Published, frontMatter.Revision)
+	}
+
+	// Publishing all drafts still leaves the scheduled ones.
+	err = PublishAll(now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, err := os.Stat("testdata/entries/draft/future.md"); err != nil {
+		t.Errorf("Expected future.md to remain a draft: %s", err)
+	}
+}
+
+func TestPublishSkipsBrokenDrafts(t *testing.T) {
+	drafts := map[string]string{
+		"on-time": "---\ntitle: on-time\npublished: 2024-02-01\nrevision: 2024-02-01\nexcerpt: Scheduled.\npublish_at: 2024-03-01T10:00:00Z\n---\n\nBody.\n",
+		"broken":  "---\ntitle: broken\ntags: [go, ts\n---\n\nBody.\n",
+	}
+	for name, content := range drafts {
+		err := os.WriteFile(filepath.Join("testdata/entries/draft", name+".md"), []byte(content), 0644)
+		if err != nil {
+			t.Fatalf("Error writing draft: %s", err)
+		}
+	}
+	t.Cleanup(func() {
+		os.Remove("testdata/entries/draft/broken.md")
+		os.Remove("testdata/entries/published/on-time.md")
+		os.Remove("testdata/docs/blog/on-time.html")
+	})
+
+	published, err := PublishDue(time.Date(2024, 3, 1, 11, 0, 0, 0, time.UTC))
+	if err == nil || !strings.Contains(err.Error(), "testdata/entries/draft/broken.md") {
+		t.Errorf("want error for broken.md, got %v", err)
+	}
+	if len(published) != 1 || published[0] != "testdata/entries/draft/on-time.md" {
+		t.Errorf("want on-time.md published, got %v", published)
+	}
+	if _, err := os.Stat("testdata/entries/published/on-time.md"); err != nil {
+		t.Errorf("Expected on-time.md to be moved to published: %s", err)
+	}
+
+	err = PublishAll(time.Date(2024, 3, 1, 11, 0, 0, 0, time.UTC))
+	if err == nil || !strings.Contains(err.Error(), "testdata/entries/draft/broken.md") {
+		t.Errorf("want PublishAll to return the error of broken.md, got %v", err)
+	}
+}
+
+func TestGenerateIndexCreatesTagPages(t *testing.T) {
+	published := "testdata/entries/published/tagged.md"
+	content := "---\ntitle: tagged\npublished: 2024-03-01\nrevision: 2024-03-01\ntags: [go, postgres]\nexcerpt: Tagged.\n---\n\nBody.\n"
+	err := os.WriteFile(published, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Error writing entry: %s", err)
+	}
+	stale := "testdata/docs/tags/cobol.html"
+	err = os.MkdirAll("testdata/docs/tags", 0755)
+	if err != nil {
+		t.Fatalf("Error creating tags dir: %s", err)
+	}
+	err = os.WriteFile(stale, []byte("<html></html>"), 0644)
+	if err != nil {
+		t.Fatalf("Error writing stale tag page: %s", err)
+	}
+	t.Cleanup(func() {
+		os.Remove(published)
+		os.Remove("testdata/docs/blog.html")
+		os.RemoveAll("testdata/docs/tags")
+	})
+
+	err = GenerateIndex()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	for _, tag := range []string{"go", "postgres"} {
+		page, err := os.ReadFile(filepath.Join("testdata/docs/tags", tag+".html"))
+		if err != nil {
+			t.Errorf("Expected a page for tag %q: %s", tag, err)
+			continue
+		}
+		if !strings.Contains(string(page), `href="/blog/tagged.html"`) {
+			t.Errorf("Expected tag page %q to link to the entry", tag)
+		}
+	}
+
+	index, err := os.ReadFile("testdata/docs/blog.html")
+	if err != nil {
+		t.Fatalf("Error reading index: %s", err)
+	}
+	if !strings.Contains(string(index), `href="/tags/go.html"`) {
+		t.Error("Expected index to link to the tag page")
+	}
+	if !strings.Contains(string(index), `1 min read`) {
+		t.Error("Expected index to show the reading time")
+	}
+
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("Expected stale tag page to be removed, got %v", err)
+	}
+}
+
+func TestRenderAddsSocialMetadata(t *testing.T) {
+	published := "testdata/entries/published/with-cover.md"
+	page := "testdata/docs/blog/with-cover.html"
+	content := "---\ntitle: With Cover\nslug: with-cover\npublished: 2024-03-01\nrevision: 2024-03-05\ntags: [go]\nauthors: [German]\ncover: /assets/cover.png\nexcerpt: An excerpt.\n---\n\nBody.\n"
+	err := os.WriteFile(published, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Error writing entry: %s", err)
+	}
+	t.Cleanup(func() {
+		os.Remove(published)
+		os.Remove(page)
+	})
+
+	_, err = render(published, pageOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	html, err := os.ReadFile(page)
+	if err != nil {
+		t.Fatalf("Error reading page: %s", err)
+	}
+
+	want := []string{
+		`<link rel="canonical" href="https://germandv.me/blog/with-cover.html" />`,
+		`<meta property="og:title" content="With Cover" />`,
+		`<meta property="og:image" content="https://germandv.me/assets/cover.png" />`,
+		`<meta property="article:published_time" content="2024-03-01T00:00:00Z" />`,
+		`<meta property="article:modified_time" content="2024-03-05T00:00:00Z" />`,
+		`<meta property="article:tag" content="go" />`,
+		`<meta name="twitter:card" content="summary_large_image" />`,
+		`"@type":"BlogPosting"`,
+		`"author":[{"@type":"Person","name":"German"}]`,
+		`"dateModified":"2024-03-05T00:00:00Z"`,
+		`"wordCount":1,"timeRequired":"PT1M"`,
+		`<span class="reading">1 min read &middot; 1 words</span>`,
+	}
+	for _, w := range want {
+		if !strings.Contains(string(html), w) {
+			t.Errorf("Expected page to contain %s", w)
+		}
+	}
+}
+
+func TestToHTMLHighlightsCode(t *testing.T) {
+	t.Parallel()
+
+	html, err := ToHTML([]byte("```go\nreturn nil\n```\n\n```\n<plain>\n```\n"), "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	got := string(html)
+
+	want := []string{
+		`<pre><code class="language-go"><span class="hl-keyword">return</span> <span class="hl-literal">nil</span>` + "\n</code></pre>",
+		"<pre><code>&lt;plain&gt;\n</code></pre>",
+	}
+	for _, w := range want {
+		if !strings.Contains(got, w) {
+			t.Errorf("Expected %q in\n%s", w, got)
+		}
+	}
+}
+
+func TestRenderAddsTableOfContents(t *testing.T) {
+	published := "testdata/entries/published/with-toc.md"
+	page := "testdata/docs/blog/with-toc.html"
+	content := "---\ntitle: with-toc\npublished: 2024-03-01\nrevision: 2024-03-01\nexcerpt: Long.\ntoc: true\n---\n\n## First\n\n### Nested\n\n## Second\n"
+	err := os.WriteFile(published, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Error writing entry: %s", err)
+	}
+	t.Cleanup(func() {
+		os.Remove(published)
+		os.Remove(page)
+	})
+
+	_, err = render(published, pageOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	html, err := os.ReadFile(page)
+	if err != nil {
+		t.Fatalf("Error reading page: %s", err)
+	}
+
+	toc := regexp.MustCompile(`(?s)<nav class="toc">.*?</nav>`).FindString(string(html))
+	if toc == "" {
+		t.Fatal("Expected a table of contents")
+	}
+	links := regexp.MustCompile(`href="#([^"]+)"|<ul>|</ul>`).FindAllString(toc, -1)
+	want := `<ul> href="#first" <ul> href="#nested" </ul> href="#second" </ul>`
+	if strings.Join(links, " ") != want {
+		t.Errorf("want %s, got %s", want, strings.Join(links, " "))
+	}
+}
+
+func TestRenderAddsResponsiveImages(t *testing.T) {
+	published := "testdata/entries/published/with-image.md"
+	page := "testdata/docs/blog/with-image.html"
+	content := "---\ntitle: with-image\npublished: 2024-03-01\nrevision: 2024-03-01\nexcerpt: Pictures.\n---\n\n![A chart](/assets/chart.png)\n\n![Remote](https://example.com/a.png)\n"
+	err := os.WriteFile(published, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Error writing entry: %s", err)
+	}
+	err = os.MkdirAll("testdata/docs/assets", 0755)
+	if err != nil {
+		t.Fatalf("Error creating assets dir: %s", err)
+	}
+	f, err := os.Create("testdata/docs/assets/chart.png")
+	if err != nil {
+		t.Fatalf("Error creating image: %s", err)
+	}
+	err = png.Encode(f, image.NewGray(image.Rect(0, 0, 1000, 600)))
+	f.Close()
+	if err != nil {
+		t.Fatalf("Error encoding image: %s", err)
+	}
+	t.Cleanup(func() {
+		os.Remove(published)
+		os.Remove(page)
+		os.RemoveAll("testdata/docs/assets")
+		os.RemoveAll("testdata/docs/blog/img")
+	})
+
+	_, err = render(published, pageOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	html, err := os.ReadFile(page)
+	if err != nil {
+		t.Fatalf("Error reading page: %s", err)
+	}
+
+	img := regexp.MustCompile(`<img src="/assets/chart.png"[^>]*>`).FindString(string(html))
+	want := []string{
+		`alt="A chart"`,
+		`width="1000" height="600"`,
+		`loading="lazy"`,
+		`srcset="/blog/img/chart-`,
+		`-960.png 960w, /assets/chart.png 1000w"`,
+		`sizes="`,
+	}
+	for _, w := range want {
+		if !strings.Contains(img, w) {
+			t.Errorf("Expected %s in %q", w, img)
+		}
+	}
+	if !strings.Contains(string(html), `<img src="https://example.com/a.png" alt="Remote" />`) {
+		t.Error("Expected remote image to be left alone")
+	}
+
+	variants, _ := filepath.Glob("testdata/docs/blog/img/chart-*.png")
+	if len(variants) != 2 {
+		t.Errorf("want 2 variants, got %v", variants)
+	}
+
+	stale := "testdata/docs/blog/img/old-chart-0123456789ab-480.png"
+	err = os.WriteFile(stale, []byte("stale"), 0644)
+	if err != nil {
+		t.Fatalf("Error writing stale image: %s", err)
+	}
+	t.Cleanup(func() {
+		os.Remove("testdata/docs/blog.html")
+		os.RemoveAll("testdata/docs/tags")
+	})
+
+	err = Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("Expected unused image to be removed, got %v", err)
+	}
+	kept, _ := filepath.Glob("testdata/docs/blog/img/chart-*.png")
+	if len(kept) != 2 {
+		t.Errorf("want the 2 variants in use kept, got %v", kept)
+	}
+}
+
+func TestPublishBundleCopiesAssets(t *testing.T) {
+	draft := "testdata/entries/draft/with-data/index.md"
+	published := "testdata/entries/published/with-data"
+	page := "testdata/docs/blog/with-data.html"
+	assets := "testdata/docs/blog/with-data"
+	content := "---\ntitle: with-data\npublished: 2024-03-01\nrevision: 2024-03-01\nexcerpt: Data.\n---\n\n" +
+		"Get [the data](data/points.txt), see [above](#top) or go [home](/index.html).\n\n" +
+		"Download {{< file \"data/points.txt\" >}}.\n"
+
+	err := os.MkdirAll("testdata/entries/draft/with-data/data", 0755)
+	if err != nil {
+		t.Fatalf("Error creating bundle: %s", err)
+	}
+	err = os.WriteFile(draft, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Error writing entry: %s", err)
+	}
+	err = os.WriteFile("testdata/entries/draft/with-data/data/points.txt", []byte("1,2\n"), 0644)
+	if err != nil {
+		t.Fatalf("Error writing asset: %s", err)
+	}
+	t.Cleanup(func() {
+		for _, f := range []string{"testdata/entries/draft/with-data", published, page, assets} {
+			os.RemoveAll(f)
+		}
+	})
+
+	err = Publish(draft)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(published, "data/points.txt")); err != nil {
+		t.Errorf("Expected bundle to be moved to published: %s", err)
+	}
+	if _, err := os.Stat(filepath.Join(assets, "data/points.txt")); err != nil {
+		t.Errorf("Expected asset to be copied: %s", err)
+	}
+	if _, err := os.Stat(filepath.Join(assets, "index.md")); !os.IsNotExist(err) {
+		t.Errorf("Expected markdown not to be copied, got %v", err)
+	}
+
+	html, err := os.ReadFile(page)
+	if err != nil {
+		t.Fatalf("Error reading page: %s", err)
+	}
+	for _, link := range []string{
+		`href="/blog/with-data/data/points.txt"`,
+		`<a class="file" href="/blog/with-data/data/points.txt" download>points.txt</a> (4 B)`,
+		`href="#top"`,
+		`href="/index.html"`,
+	} {
+		if !strings.Contains(string(html), link) {
+			t.Errorf("Expected %s in page", link)
+		}
+	}
+
+	// Assets of removed bundles are cleaned up with their page.
+	err = os.RemoveAll(published)
+	if err != nil {
+		t.Fatalf("Error removing bundle: %s", err)
+	}
+	err = Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	t.Cleanup(func() {
+		os.Remove("testdata/docs/blog.html")
+		os.RemoveAll("testdata/docs/tags")
+	})
+	if _, err := os.Stat(assets); !os.IsNotExist(err) {
+		t.Errorf("Expected bundle assets to be removed, got %v", err)
+	}
+}
+
+func TestPreviewLeavesDocsUntouched(t *testing.T) {
+	draft := "testdata/entries/draft/previewed/index.md"
+	content := "---\ntitle: previewed\npublished: 2024-03-01\nrevision: 2024-03-01\nexcerpt: Preview.\n---\n\n" +
+		"![A chart](chart.png) and {{< file \"data/points.txt\" >}}.\n"
+
+	err := os.MkdirAll("testdata/entries/draft/previewed/data", 0755)
+	if err != nil {
+		t.Fatalf("Error creating bundle: %s", err)
+	}
+	t.Cleanup(func() { os.RemoveAll("testdata/entries/draft/previewed") })
+	err = os.WriteFile(draft, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Error writing entry: %s", err)
+	}
+	err = os.WriteFile("testdata/entries/draft/previewed/data/points.txt", []byte("1,2\n"), 0644)
+	if err != nil {
+		t.Fatalf("Error writing asset: %s", err)
+	}
+	f, err := os.Create("testdata/entries/draft/previewed/chart.png")
+	if err != nil {
+		t.Fatalf("Error creating image: %s", err)
+	}
+	err = png.Encode(f, image.NewGray(image.Rect(0, 0, 1000, 600)))
+	f.Close()
+	if err != nil {
+		t.Fatalf("Error encoding image: %s", err)
+	}
+
+	_, e, err := Preview(draft)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	for _, want := range []string{`<img src="chart.png" alt="A chart" />`, `<a class="file" href="data/points.txt" download>`} {
+		if !strings.Contains(string(e.Body), want) {
+			t.Errorf("Expected %s in\n%s", want, e.Body)
+		}
+	}
+	for _, dir := range []string{"testdata/docs/blog/previewed", "testdata/docs/blog/img"} {
+		if _, err := os.Stat(dir); !os.IsNotExist(err) {
+			t.Errorf("Expected %s not to be created, got %v", dir, err)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package editor
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"germandv.xyz/internal/entry"
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	yamlDelimiter = "---"
+	tomlDelimiter = "+++"
+)
+
+// readFrontMatter detects the format of the front matter by its opening line:
+// `---` for YAML, `+++` for TOML and `{` for a JSON object.
+func readFrontMatter(scanner *bufio.Scanner) (*entry.FrontMatter, error) {
+	for scanner.Scan() {
+		line := strings.Trim(scanner.Text(), " ")
+
+		switch {
+		case line == yamlDelimiter:
+			lines, err := readUntil(scanner, yamlDelimiter)
+			if err != nil {
+				return nil, err
+			}
+			return parseYAML(lines)
+		case line == tomlDelimiter:
+			lines, err := readUntil(scanner, tomlDelimiter)
+			if err != nil {
+				return nil, err
+			}
+			return parseTOML(lines)
+		case strings.HasPrefix(line, "{"):
+			return parseJSON(scanner, scanner.Text())
+		}
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, errors.New("no content found")
+}
+
+// readUntil returns the lines up to the closing delimiter.
+func readUntil(scanner *bufio.Scanner, delimiter string) ([]string, error) {
+	lines := []string{}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.Trim(line, " ") == delimiter {
+			return lines, nil
+		}
+		lines = append(lines, line)
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, errors.New("no content found")
+}
+
+// parseYAML decodes the lines between delimiters in the flat format used before
+// YAML support, when they are all simple `key: value` pairs, or as YAML otherwise.
+// Flat values are kept as written, even when they are not valid YAML
+// (e.g. an excerpt containing ": ") or mean something else in YAML (e.g. `#` or `&`).
+func parseYAML(lines []string) (*entry.FrontMatter, error) {
+	var raw map[string]any
+	var err error
+	if isFlat(lines) {
+		raw, err = decodeFlat(lines)
+	} else {
+		raw, err = decodeYAML([]byte(strings.Join(lines, "\n")))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return entry.NewFrontMatter(raw)
+}
+
+func parseTOML(lines []string) (*entry.FrontMatter, error) {
+	raw := make(map[string]any)
+	_, err := toml.Decode(strings.Join(lines, "\n"), &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry.NewFrontMatter(raw)
+}
+
+// parseJSON reads lines, starting with `first`, until they form a complete JSON object.
+func parseJSON(scanner *bufio.Scanner, first string) (*entry.FrontMatter, error) {
+	data := []byte(first)
+
+	for !json.Valid(data) {
+		if !scanner.Scan() {
+			err := scanner.Err()
+			if err != nil {
+				return nil, err
+			}
+			return nil, errors.New("unterminated JSON front matter")
+		}
+		data = append(data, '\n')
+		data = append(data, scanner.Bytes()...)
+	}
+
+	raw := make(map[string]any)
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry.NewFrontMatter(raw)
+}
+
+func decodeYAML(data []byte) (map[string]any, error) {
+	var doc yaml.Node
+	err := yaml.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Empty front matter.
+	if len(doc.Content) == 0 {
+		return map[string]any{}, nil
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("line %d: front matter must be a set of key-value pairs", root.Line)
+	}
+
+	raw, ok := yamlValue(root).(map[string]any)
+	if !ok {
+		return nil, errors.New("invalid front matter")
+	}
+
+	return raw, nil
+}
+
+// yamlValue converts a YAML node into plain Go values.
+// Scalars are kept as written (e.g. dates are not parsed),
+// leaving the interpretation to `entry.NewFrontMatter`.
+func yamlValue(node *yaml.Node) any {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return yamlValue(node.Alias)
+	case yaml.SequenceNode:
+		list := make([]any, 0, len(node.Content))
+		for _, item := range node.Content {
+			list = append(list, yamlValue(item))
+		}
+		return list
+	case yaml.MappingNode:
+		mapping := make(map[string]any, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			mapping[node.Content[i].Value] = yamlValue(node.Content[i+1])
+		}
+		return mapping
+	default:
+		if node.ShortTag() == "!!null" {
+			return nil
+		}
+		return node.Value
+	}
+}
+
+// flatPair is a `key: value` line of the flat format, not indented.
+var flatPair = regexp.MustCompile(`^[A-Za-z_][\w-]*:(\s|$)`)
+
+// isFlat reports whether every line is a flat `key: value` pair whose value does
+// not start with YAML-only syntax: quotes, flow collections or block scalars.
+func isFlat(lines []string) bool {
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if !flatPair.MatchString(line) {
+			return false
+		}
+		value := strings.TrimSpace(line[strings.Index(line, ":")+1:])
+		if value == "" || strings.ContainsAny(value[:1], `"'[{|>`) {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeFlat reads one `key: value` pair per line.
+func decodeFlat(lines []string) (map[string]any, error) {
+	raw := make(map[string]any)
+
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		keyvalue := strings.SplitN(strings.Trim(line, " "), ":", 2)
+		if len(keyvalue) != 2 {
+			return nil, errors.New("invalid front matter key-value pair")
+		}
+		raw[keyvalue[0]] = strings.Trim(keyvalue[1], " ")
+	}
+
+	return raw, nil
+}
+
+// dateField matches the value of the `published` and `revision` fields in YAML
+// (`published: 2024-03-01`), TOML (`published = 2024-03-01`) and JSON (`"published": "2024-03-01"`).
+var dateField = regexp.MustCompile(`^(\s*"?(?:published|revision)"?\s*[:=]\s*"?)[^"\s,]*`)
+
+// setPublishDate rewrites the `published` and `revision` dates in the front matter of `file`.
+func setPublishDate(file string, date time.Time) error {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	lines := strings.Split(string(content), "\n")
+
+	// The front matter ends at the delimiter it opens with, or the closing brace for JSON.
+	closing := ""
+	for i, line := range lines {
+		trimmed := strings.Trim(line, " ")
+		if closing == "" {
+			if trimmed == "" {
+				continue
+			}
+			closing = trimmed
+			if strings.HasPrefix(trimmed, "{") {
+				closing = "}"
+			}
+			continue
+		}
+		if trimmed == closing || (closing == "}" && strings.HasPrefix(trimmed, "}")) {
+			break
+		}
+		lines[i] = dateField.ReplaceAllString(line, "${1}"+date.Format(entry.InputDateFormat))
+	}
+
+	return os.WriteFile(file, []byte(strings.Join(lines, "\n")), 0644)
+}
@@ -0,0 +1,242 @@
+package editor
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadFrontMatter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     string
+		title     string
+		published string
+		tags      []string
+		authors   []string
+		excerpt   string
+		toc       bool
+	}{
+		{
+			name: "flat",
+			input: `---
+title: a-title
+published: 2022-10-18
+tags: go,postgres
+excerpt: Just an excerpt.
+---`,
+			title:     "a-title",
+			published: "2022-10-18",
+			tags:      []string{"go", "postgres"},
+			excerpt:   "Just an excerpt.",
+		},
+		{
+			name: "flat with colon in value",
+			input: `---
+title: a-title
+excerpt: Note: not valid YAML.
+---`,
+			title:   "a-title",
+			excerpt: "Note: not valid YAML.",
+		},
+		{
+			name: "flat with YAML comment and anchor characters",
+			input: `---
+title: &copy and more
+tags: go
+excerpt: Fixing issue #42 in the parser
+---`,
+			title:   "&copy and more",
+			tags:    []string{"go"},
+			excerpt: "Fixing issue #42 in the parser",
+		},
+		{
+			name: "lists, block scalar and boolean",
+			input: `---
+title: "a-title"
+published: 2022-10-18
+tags:
+  - go
+  - ts
+authors: [German, Jose]
+toc: true
+excerpt: >
+  A multi-line
+  excerpt.
+---`,
+			title:     "a-title",
+			published: "2022-10-18",
+			tags:      []string{"go", "ts"},
+			authors:   []string{"German", "Jose"},
+			excerpt:   "A multi-line excerpt.",
+			toc:       true,
+		},
+		{
+			name: "nested values are allowed",
+			input: `---
+title: a-title
+extra:
+  key: value
+---`,
+			title: "a-title",
+		},
+		{
+			name: "toml",
+			input: `+++
+title = "a-title"
+published = 2022-10-18
+tags = ["go", "ts"]
+toc = true
+excerpt = """
+A multi-line
+excerpt."""
++++`,
+			title:     "a-title",
+			published: "2022-10-18",
+			tags:      []string{"go", "ts"},
+			excerpt:   "A multi-line\nexcerpt.",
+			toc:       true,
+		},
+		{
+			name: "json",
+			input: `{
+  "title": "a-title",
+  "published": "2022-10-18",
+  "tags": ["go", "ts"],
+  "authors": ["German"],
+  "excerpt": "Braces {} in strings."
+}
+
+# A heading`,
+			title:     "a-title",
+			published: "2022-10-18",
+			tags:      []string{"go", "ts"},
+			authors:   []string{"German"},
+			excerpt:   "Braces {} in strings.",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scanner := bufio.NewScanner(strings.NewReader(tt.input))
+			fm, err := readFrontMatter(scanner)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if fm.Title != tt.title {
+				t.Errorf("want title %q, got %q", tt.title, fm.Title)
+			}
+			if fm.Published != tt.published {
+				t.Errorf("want published %q, got %q", tt.published, fm.Published)
+			}
+			if fm.Excerpt != tt.excerpt {
+				t.Errorf("want excerpt %q, got %q", tt.excerpt, fm.Excerpt)
+			}
+			if fm.TOC != tt.toc {
+				t.Errorf("want toc %t, got %t", tt.toc, fm.TOC)
+			}
+			if strings.Join(fm.Tags, ",") != strings.Join(tt.tags, ",") {
+				t.Errorf("want tags %v, got %v", tt.tags, fm.Tags)
+			}
+			if strings.Join(fm.Authors, ",") != strings.Join(tt.authors, ",") {
+				t.Errorf("want authors %v, got %v", tt.authors, fm.Authors)
+			}
+		})
+	}
+}
+
+func TestReadFrontMatterWithoutClosingDelimiter(t *testing.T) {
+	t.Parallel()
+
+	inputs := []string{
+		"---\ntitle: a-title\n",
+		"+++\ntitle = \"a-title\"\n",
+		"{\n  \"title\": \"a-title\"\n",
+	}
+
+	for _, input := range inputs {
+		scanner := bufio.NewScanner(strings.NewReader(input))
+		_, err := readFrontMatter(scanner)
+		if err == nil {
+			t.Errorf("want error for %q, got nil", input)
+		}
+	}
+}
+
+func TestReadFrontMatterInvalidYAML(t *testing.T) {
+	t.Parallel()
+
+	inputs := []string{
+		"---\ntitle: a-title\ntags: [go, ts\n---\n",
+		"---\ntitle: \"a-title\n---\n",
+	}
+
+	for _, input := range inputs {
+		scanner := bufio.NewScanner(strings.NewReader(input))
+		_, err := readFrontMatter(scanner)
+		if err == nil || !strings.Contains(err.Error(), "yaml:") {
+			t.Errorf("want YAML error for %q, got %v", input, err)
+		}
+	}
+}
+
+func TestSetPublishDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "yaml",
+			input: "---\ntitle: a-title\npublished: 2024-02-01\nrevision: '2024-02-02'\n---\n\npublished: 2024-02-01\n",
+			want:  "---\ntitle: a-title\npublished: 2024-03-01\nrevision: 2024-03-01\n---\n\npublished: 2024-02-01\n",
+		},
+		{
+			name:  "toml",
+			input: "+++\ntitle = \"a-title\"\npublished = 2024-02-01\nrevision = \"2024-02-02\"\n+++\n",
+			want:  "+++\ntitle = \"a-title\"\npublished = 2024-03-01\nrevision = \"2024-03-01\"\n+++\n",
+		},
+		{
+			name:  "json",
+			input: "{\n  \"published\": \"2024-02-01\",\n  \"revision\": \"2024-02-02\"\n}\n",
+			want:  "{\n  \"published\": \"2024-03-01\",\n  \"revision\": \"2024-03-01\"\n}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			file := filepath.Join(t.TempDir(), "entry.md")
+			err := os.WriteFile(file, []byte(tt.input), 0644)
+			if err != nil {
+				t.Fatalf("Error writing entry: %s", err)
+			}
+
+			err = setPublishDate(file, time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			got, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatalf("Error reading entry: %s", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("want %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
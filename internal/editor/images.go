@@ -0,0 +1,72 @@
+package editor
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"germandv.xyz/internal/filer"
+	"germandv.xyz/internal/images"
+)
+
+// imageSizes tells browsers how wide images are displayed, at most the width of `main`.
+const imageSizes = "(max-width: 960px) 90vw, 960px"
+
+var (
+	imgTag  = regexp.MustCompile(`<img\s[^>]*>`)
+	imgSrc  = regexp.MustCompile(`\ssrc="([^"]*)"`)
+	imgSize = regexp.MustCompile(`\s(width|height|srcset)=`)
+)
+
+// responsiveImages adds resized variants, intrinsic size and lazy loading
+// to the <img> tags of local PNG and JPEG images.
+// The names of the variants referenced are added to `used`, if not nil.
+func responsiveImages(body template.HTML, used map[string]bool) (template.HTML, error) {
+	var failed error
+
+	out := imgTag.ReplaceAllStringFunc(string(body), func(tag string) string {
+		m := imgSrc.FindStringSubmatch(tag)
+		if m == nil || failed != nil || imgSize.MatchString(tag) {
+			return tag
+		}
+
+		ref, err := url.Parse(html.UnescapeString(m[1]))
+		if err != nil || ref.Scheme != "" || ref.Host != "" || !images.Supported(ref.Path) {
+			return tag
+		}
+
+		img, err := images.Process(filer.LocalPath(ref.Path), filer.ImagesDir(), site.BlogPath+"img/")
+		if err != nil {
+			failed = fmt.Errorf("image %q: %w", m[1], err)
+			return tag
+		}
+
+		attrs := fmt.Sprintf(` width="%d" height="%d" loading="lazy" decoding="async"`, img.Width, img.Height)
+		if len(img.Variants) > 0 {
+			srcset := []string{}
+			for _, v := range img.Variants {
+				srcset = append(srcset, fmt.Sprintf("%s %dw", v.URL, v.Width))
+				if used != nil {
+					used[v.Name] = true
+				}
+			}
+			srcset = append(srcset, fmt.Sprintf("%s %dw", m[1], img.Width))
+			attrs += fmt.Sprintf(` srcset="%s" sizes="%s"`, strings.Join(srcset, ", "), imageSizes)
+		}
+
+		end := strings.TrimRight(strings.TrimSuffix(tag, ">"), " /")
+		closing := ">"
+		if strings.HasSuffix(tag, "/>") {
+			closing = " />"
+		}
+		return end + attrs + closing
+	})
+
+	if failed != nil {
+		return "", failed
+	}
+	return template.HTML(out), nil
+}
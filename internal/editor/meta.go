@@ -0,0 +1,75 @@
+package editor
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+
+	"germandv.xyz/internal/entry"
+)
+
+type person struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+// blogPosting is the schema.org structured data of an entry.
+type blogPosting struct {
+	Context          string   `json:"@context"`
+	Type             string   `json:"@type"`
+	Headline         string   `json:"headline"`
+	Description      string   `json:"description,omitempty"`
+	URL              string   `json:"url"`
+	MainEntityOfPage string   `json:"mainEntityOfPage"`
+	DatePublished    string   `json:"datePublished"`
+	DateModified     string   `json:"dateModified"`
+	Image            string   `json:"image,omitempty"`
+	Author           []person `json:"author,omitempty"`
+	Keywords         []string `json:"keywords,omitempty"`
+	WordCount        int      `json:"wordCount,omitempty"`
+	TimeRequired     string   `json:"timeRequired,omitempty"`
+}
+
+// addMeta sets the fields of the entry that depend on the site:
+// its canonical URL, the image to share and the JSON-LD structured data.
+func addMeta(e *entry.HtmlEntry) error {
+	e.URL = site.PostURL(e.Filename)
+
+	e.Image = site.AbsURL(e.Cover)
+	if e.Image == "" {
+		e.Image = site.AbsURL(site.Image)
+	}
+
+	authors := e.Authors
+	if len(authors) == 0 && site.Author != "" {
+		authors = []string{site.Author}
+	}
+	people := []person{}
+	for _, name := range authors {
+		people = append(people, person{Type: "Person", Name: name})
+	}
+
+	data, err := json.Marshal(blogPosting{
+		Context:          "https://schema.org",
+		Type:             "BlogPosting",
+		Headline:         e.Title,
+		Description:      e.Excerpt,
+		URL:              e.URL,
+		MainEntityOfPage: e.URL,
+		DatePublished:    e.PublishedISO,
+		DateModified:     e.RevisionISO,
+		Image:            e.Image,
+		Author:           people,
+		Keywords:         e.Tags,
+		WordCount:        e.WordCount,
+		TimeRequired:     fmt.Sprintf("PT%dM", e.ReadingTime),
+	})
+	if err != nil {
+		return err
+	}
+
+	// json.Marshal escapes <, > and &, so it is safe within a <script> tag.
+	e.JSONLD = template.JS(data)
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package editor
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"germandv.xyz/internal/entry"
+	"germandv.xyz/internal/highlight"
+	"github.com/russross/blackfriday/v2"
+)
+
+// renderer is the blackfriday HTML renderer with syntax highlighting of fenced code blocks,
+// anchors for headings and callouts.
+type renderer struct {
+	*blackfriday.HTMLRenderer
+	// headings are the h2 to h4 found in the document, in order.
+	headings []*entry.Heading
+	ids      map[string]bool
+	// callouts are the block quotes rendered as callouts.
+	callouts map[*blackfriday.Node]bool
+}
+
+func newRenderer() *renderer {
+	return &renderer{
+		HTMLRenderer: blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{
+			Flags: blackfriday.CommonHTMLFlags,
+		}),
+		ids:      make(map[string]bool),
+		callouts: make(map[*blackfriday.Node]bool),
+	}
+}
+
+func (r *renderer) RenderNode(w io.Writer, node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+	switch {
+	case node.Type == blackfriday.CodeBlock:
+		r.renderCode(w, node)
+		return blackfriday.GoToNext
+	case node.Type == blackfriday.Heading && node.Level >= 2 && node.Level <= 4:
+		r.renderHeading(w, node, entering)
+		return blackfriday.GoToNext
+	case node.Type == blackfriday.BlockQuote:
+		if r.renderCallout(w, node, entering) {
+			return blackfriday.GoToNext
+		}
+	}
+
+	return r.HTMLRenderer.RenderNode(w, node, entering)
+}
+
+// renderCode writes a code block, the first word of its info string is the language.
+func (r *renderer) renderCode(w io.Writer, node *blackfriday.Node) {
+	lang := ""
+	if fields := strings.Fields(string(node.Info)); len(fields) > 0 {
+		lang = fields[0]
+	}
+
+	io.WriteString(w, "<pre><code")
+	if lang != "" {
+		io.WriteString(w, ` class="language-`+html.EscapeString(lang)+`"`)
+	}
+	io.WriteString(w, ">")
+	io.WriteString(w, highlight.Code(lang, string(node.Literal)))
+	io.WriteString(w, "</code></pre>\n")
+}
+
+// renderHeading writes a heading with an id derived from its text,
+// and a link to itself shown on hover.
+func (r *renderer) renderHeading(w io.Writer, node *blackfriday.Node, entering bool) {
+	if entering {
+		title := plainText(node)
+		heading := &entry.Heading{ID: r.uniqueID(title), Title: title, Level: node.Level}
+		r.headings = append(r.headings, heading)
+		fmt.Fprintf(w, `<h%d id="%s">`, node.Level, heading.ID)
+		return
+	}
+
+	id := r.headings[len(r.headings)-1].ID
+	fmt.Fprintf(w, ` <a class="anchor" href="#%s" aria-hidden="true">#</a></h%d>`+"\n", id, node.Level)
+}
+
+// calloutMarker is the first line of a GitHub-style callout, e.g. `> [!NOTE]`.
+var calloutMarker = regexp.MustCompile(`(?i)^\[!(NOTE|TIP|IMPORTANT|WARNING|CAUTION)\][ \t]*(\n|$)`)
+
+// renderCallout writes a block quote starting with a callout marker as an <aside>,
+// with a class per kind. It reports false for regular block quotes.
+func (r *renderer) renderCallout(w io.Writer, node *blackfriday.Node, entering bool) bool {
+	if !entering {
+		if r.callouts[node] {
+			io.WriteString(w, "</aside>\n")
+		}
+		return r.callouts[node]
+	}
+
+	para := node.FirstChild
+	if para == nil || para.Type != blackfriday.Paragraph || para.FirstChild == nil || para.FirstChild.Type != blackfriday.Text {
+		return false
+	}
+	text := para.FirstChild
+	m := calloutMarker.FindSubmatchIndex(text.Literal)
+	if m == nil {
+		return false
+	}
+
+	kind := strings.ToLower(string(text.Literal[m[2]:m[3]]))
+	r.callouts[node] = true
+
+	// Drop the marker, and the paragraph if nothing else is left in it.
+	text.Literal = text.Literal[m[1]:]
+	if len(text.Literal) == 0 && text.Next == nil {
+		para.Unlink()
+	}
+
+	fmt.Fprintf(w, `<aside class="callout callout-%s">`+"\n", kind)
+	fmt.Fprintf(w, `<p class="callout-title">%s</p>`+"\n", strings.ToUpper(kind[:1])+kind[1:])
+	return true
+}
+
+// uniqueID slugifies the title of a heading, appending a numeric suffix
+// if another heading in the document has the same one.
+func (r *renderer) uniqueID(title string) string {
+	id := entry.Slugify(title)
+	if id == "" {
+		id = "section"
+	}
+
+	candidate := id
+	for i := 2; r.ids[candidate]; i++ {
+		candidate = fmt.Sprintf("%s-%d", id, i)
+	}
+	r.ids[candidate] = true
+
+	return candidate
+}
+
+// plainText returns the text of a node without markup.
+func plainText(node *blackfriday.Node) string {
+	var b strings.Builder
+	node.Walk(func(n *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if entering && (n.Type == blackfriday.Text || n.Type == blackfriday.Code) {
+			b.Write(n.Literal)
+		}
+		return blackfriday.GoToNext
+	})
+	return strings.TrimSpace(b.String())
+}
+
+// sortedHeadings returns the headings in the order they appear in `html`.
+// Headings in shortcodes are rendered before the rest of the page.
+func (r *renderer) sortedHeadings(html string) []*entry.Heading {
+	pos := make(map[*entry.Heading]int, len(r.headings))
+	for _, h := range r.headings {
+		pos[h] = strings.Index(html, fmt.Sprintf(`<h%d id="%s">`, h.Level, h.ID))
+	}
+
+	sort.SliceStable(r.headings, func(i, j int) bool {
+		return pos[r.headings[i]] < pos[r.headings[j]]
+	})
+	return r.headings
+}
+
+// nestHeadings turns the flat list of headings into a tree,
+// where each heading contains the following ones with a greater level.
+func nestHeadings(headings []*entry.Heading) []*entry.Heading {
+	roots := []*entry.Heading{}
+	stack := []*entry.Heading{}
+
+	for _, h := range headings {
+		for len(stack) > 0 && stack[len(stack)-1].Level >= h.Level {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			roots = append(roots, h)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, h)
+		}
+		stack = append(stack, h)
+	}
+
+	return roots
+}
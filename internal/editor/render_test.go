@@ -0,0 +1,131 @@
+package editor
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConvertAddsHeadingAnchors(t *testing.T) {
+	t.Parallel()
+
+	md := "# Title\n\n## Set up\n\n### The `go.mod` file\n\n## Set up\n\n##### Small\n"
+	html, headings, err := convert([]byte(md), "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	want := []string{
+		"<h1>Title</h1>",
+		`<h2 id="set-up">Set up <a class="anchor" href="#set-up" aria-hidden="true">#</a></h2>`,
+		`<h3 id="the-go-mod-file">The <code>go.mod</code> file <a class="anchor" href="#the-go-mod-file" aria-hidden="true">#</a></h3>`,
+		`<h2 id="set-up-2">`,
+		"<h5>Small</h5>",
+	}
+	for _, w := range want {
+		if !strings.Contains(string(html), w) {
+			t.Errorf("Expected %q in\n%s", w, html)
+		}
+	}
+
+	if len(headings) != 3 {
+		t.Fatalf("want 3 headings, got %d", len(headings))
+	}
+	if headings[1].Title != "The go.mod file" {
+		t.Errorf("want plain title %q, got %q", "The go.mod file", headings[1].Title)
+	}
+}
+
+func TestConvertSharesHeadingsWithShortcodes(t *testing.T) {
+	t.Parallel()
+
+	md := "## Usage\n\n{{< note >}}\n## Usage\n{{< /note >}}\n\n## After\n"
+	html, headings, err := convert([]byte(md), "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(headings) != 3 {
+		t.Fatalf("want 3 headings, got %d", len(headings))
+	}
+	seen := make(map[string]bool)
+	last := -1
+	for _, h := range headings {
+		if seen[h.ID] {
+			t.Errorf("want unique ids, got %q twice", h.ID)
+		}
+		seen[h.ID] = true
+
+		pos := strings.Index(string(html), `<h2 id="`+h.ID+`">`)
+		if pos <= last {
+			t.Errorf("want headings in page order, got %q at %d after %d", h.ID, pos, last)
+		}
+		last = pos
+	}
+}
+
+func TestNestHeadings(t *testing.T) {
+	t.Parallel()
+
+	_, headings, err := convert([]byte("### Intro\n\n## One\n\n### One A\n\n#### Deep\n\n### One B\n\n## Two\n"), "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	toc := nestHeadings(headings)
+
+	got := []string{}
+	for _, h := range toc {
+		got = append(got, h.ID)
+		for _, child := range h.Children {
+			got = append(got, h.ID+">"+child.ID)
+			for _, grandchild := range child.Children {
+				got = append(got, child.ID+">"+grandchild.ID)
+			}
+		}
+	}
+
+	want := "intro,one,one>one-a,one-a>deep,one>one-b,two"
+	if strings.Join(got, ",") != want {
+		t.Errorf("want %s, got %s", want, strings.Join(got, ","))
+	}
+}
+
+func TestConvertRendersCallouts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		md   string
+		want string
+	}{
+		{
+			name: "marker on its own line",
+			md:   "> [!NOTE]\n> Some **bold** text.\n",
+			want: "<aside class=\"callout callout-note\">\n<p class=\"callout-title\">Note</p>\n<p>Some <strong>bold</strong> text.</p>\n</aside>\n",
+		},
+		{
+			name: "marker in its own paragraph",
+			md:   "> [!warning]\n>\n> Careful.\n",
+			want: "<aside class=\"callout callout-warning\">\n<p class=\"callout-title\">Warning</p>\n<p>Careful.</p>\n</aside>\n",
+		},
+		{
+			name: "regular block quote",
+			md:   "> [!TIPS] are not callouts.\n",
+			want: "<blockquote>\n<p>[!TIPS] are not callouts.</p>\n</blockquote>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			html, _, err := convert([]byte(tt.md), "")
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if string(html) != tt.want {
+				t.Errorf("want\n%q\ngot\n%q", tt.want, html)
+			}
+		})
+	}
+}
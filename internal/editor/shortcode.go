@@ -0,0 +1,309 @@
+package editor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"germandv.xyz/internal/config"
+	"germandv.xyz/internal/filer"
+	"germandv.xyz/internal/markdown"
+	"github.com/russross/blackfriday/v2"
+)
+
+// Shortcode is the data available to the templates in `templates/shortcodes/`.
+// `{{< figure src="a.png" caption="A" >}}` has the named params `src` and `caption`,
+// `{{< file "polygons.txt" >}}` has one positional argument, and
+// `{{< note >}}Inner text{{< /note >}}` has the raw markdown between its tags as Inner.
+type Shortcode struct {
+	Name   string
+	Args   []string
+	Params map[string]string
+	Inner  string
+}
+
+// Get returns the named param `key`, or an empty string if it is not set.
+func (sc Shortcode) Get(key string) string {
+	return sc.Params[key]
+}
+
+// Asset is a file linked by the `file` shortcode, from the page bundle or `<docs>/assets/`.
+// The URL of bundle files is relative, resolved like the other links of the bundle.
+type Asset struct {
+	Name string
+	URL  string
+	Size string
+}
+
+var (
+	shortcodeTag = regexp.MustCompile(`\{\{<\s*(/)?\s*([\w-]+)\s*(.*?)\s*>\}\}`)
+	shortcodeArg = regexp.MustCompile(`([\w-]+)=("(?:[^"\\]|\\.)*"|\S+)|("(?:[^"\\]|\\.)*"|\S+)`)
+	inlineCode   = regexp.MustCompile("`[^`\n]+`")
+)
+
+// expandShortcodes replaces the shortcodes in the markdown body with the output of their templates.
+// Shortcodes within code blocks and inline code are left alone.
+// The markdown inside shortcodes is rendered with `r`, the renderer of the page,
+// so its headings get unique ids and are part of the table of contents.
+// `entryfile` is the .md file of the body, to find the files of page bundles.
+func expandShortcodes(body []byte, r *renderer, entryfile string) ([]byte, error) {
+	if !bytes.Contains(body, []byte("{{<")) {
+		return body, nil
+	}
+
+	x := &expander{r: r, entryfile: entryfile}
+	expanded, err := x.expand(string(body), 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(expanded), nil
+}
+
+// expander parses the shortcode templates the first time one is needed.
+type expander struct {
+	tmpl      *template.Template
+	r         *renderer
+	entryfile string
+}
+
+// expand replaces the shortcodes in `text`, which starts at `line` of the body.
+func (x *expander) expand(text string, line int) (string, error) {
+	code := codeRanges(text)
+
+	var b strings.Builder
+	pos := 0
+	for _, m := range shortcodeTag.FindAllStringSubmatchIndex(text, -1) {
+		start, end := m[0], m[1]
+		if start < pos || inRanges(code, start) {
+			continue
+		}
+
+		at := line + strings.Count(text[:start], "\n")
+		name := text[m[4]:m[5]]
+		if m[2] != -1 {
+			return "", fmt.Errorf("line %d: unexpected closing shortcode %q", at, name)
+		}
+
+		args, params, err := parseShortcodeArgs(text[m[6]:m[7]])
+		if err != nil {
+			return "", fmt.Errorf("line %d: shortcode %q: %w", at, name, err)
+		}
+		sc := Shortcode{Name: name, Args: args, Params: params}
+
+		next := end
+		if closeStart, closeEnd, ok := findClosing(text, end, name, code); ok {
+			sc.Inner, err = x.expand(text[end:closeStart], line+strings.Count(text[:end], "\n"))
+			if err != nil {
+				return "", err
+			}
+			next = closeEnd
+		}
+
+		out, err := x.execute(sc)
+		if err != nil {
+			return "", fmt.Errorf("line %d: %w", at, err)
+		}
+
+		b.WriteString(text[pos:start])
+		b.WriteString(out)
+		pos = next
+	}
+	b.WriteString(text[pos:])
+
+	return b.String(), nil
+}
+
+func (x *expander) execute(sc Shortcode) (string, error) {
+	if x.tmpl == nil {
+		tmpl, err := x.parseShortcodes()
+		if err != nil {
+			return "", err
+		}
+		x.tmpl = tmpl
+	}
+
+	tmpl := x.tmpl.Lookup(sc.Name + ".html")
+	if tmpl == nil {
+		return "", fmt.Errorf("unknown shortcode %q, no template in templates/shortcodes", sc.Name)
+	}
+
+	var b strings.Builder
+	err := tmpl.Execute(&b, sc)
+	if err != nil {
+		return "", fmt.Errorf("shortcode %q: %w", sc.Name, err)
+	}
+
+	return strings.TrimSpace(b.String()), nil
+}
+
+// parseShortcodes parses the templates in `templates/shortcodes/`, named after their file.
+func (x *expander) parseShortcodes() (*template.Template, error) {
+	tmpl := template.New("shortcodes").Funcs(template.FuncMap{
+		"site":     func() *config.Site { return site },
+		"markdown": x.markdown,
+		"asset":    x.asset,
+		"snippet":  snippet,
+	})
+
+	files, err := filepath.Glob(filepath.Join("templates", "shortcodes", "*.html"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return tmpl, nil
+	}
+
+	return tmpl.ParseFiles(files...)
+}
+
+// markdown converts the inner text of a shortcode to HTML.
+func (x *expander) markdown(text string) template.HTML {
+	return template.HTML(blackfriday.Run([]byte(text), blackfriday.WithRenderer(x.r)))
+}
+
+// asset returns the file `name` from the page bundle of the entry, if any,
+// or from `<docs>/assets/`, failing if it does not exist.
+func (x *expander) asset(name string) (Asset, error) {
+	if filer.IsBundle(x.entryfile) {
+		info, err := filer.StatBundleFile(x.entryfile, name)
+		if err == nil && !info.IsDir() {
+			return Asset{
+				Name: filepath.Base(name),
+				URL:  filepath.ToSlash(name),
+				Size: humanSize(info.Size()),
+			}, nil
+		}
+	}
+
+	info, err := filer.StatAsset(name)
+	if err != nil {
+		return Asset{}, fmt.Errorf("asset %q: %w", name, err)
+	}
+	if info.IsDir() {
+		return Asset{}, fmt.Errorf("asset %q is a directory", name)
+	}
+
+	return Asset{
+		Name: filepath.Base(name),
+		URL:  "/assets/" + filepath.ToSlash(name),
+		Size: humanSize(info.Size()),
+	}, nil
+}
+
+func humanSize(size int64) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(size)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", size)
+	}
+}
+
+// parseShortcodeArgs splits `src="a.png" "b"` into named params and positional arguments.
+func parseShortcodeArgs(s string) ([]string, map[string]string, error) {
+	args := []string{}
+	params := make(map[string]string)
+
+	for _, m := range shortcodeArg.FindAllStringSubmatch(s, -1) {
+		if m[1] != "" {
+			value, err := unquote(m[2])
+			if err != nil {
+				return nil, nil, err
+			}
+			params[m[1]] = value
+			continue
+		}
+
+		value, err := unquote(m[3])
+		if err != nil {
+			return nil, nil, err
+		}
+		args = append(args, value)
+	}
+
+	return args, params, nil
+}
+
+func unquote(s string) (string, error) {
+	if !strings.HasPrefix(s, `"`) {
+		return s, nil
+	}
+
+	value, err := strconv.Unquote(s)
+	if err != nil {
+		return "", errors.New("unterminated quoted argument " + s)
+	}
+	return value, nil
+}
+
+// findClosing returns the position of the `{{< /name >}}` matching
+// a shortcode that ends at `from`, if there is one.
+func findClosing(text string, from int, name string, code [][2]int) (int, int, bool) {
+	depth := 1
+	for _, m := range shortcodeTag.FindAllStringSubmatchIndex(text[from:], -1) {
+		start, end := from+m[0], from+m[1]
+		if inRanges(code, start) || text[from+m[4]:from+m[5]] != name {
+			continue
+		}
+
+		if m[2] == -1 {
+			depth++
+			continue
+		}
+		depth--
+		if depth == 0 {
+			return start, end, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+// codeRanges returns the positions of fenced code blocks and inline code in the markdown.
+func codeRanges(text string) [][2]int {
+	ranges := [][2]int{}
+
+	open, fence := -1, ""
+	pos := 0
+	for _, line := range strings.SplitAfter(text, "\n") {
+		content := strings.TrimSuffix(line, "\n")
+		switch {
+		case open == -1:
+			if fence = markdown.Fence(content); fence != "" {
+				open = pos
+			}
+		case markdown.ClosesFence(content, fence):
+			ranges = append(ranges, [2]int{open, pos + len(content)})
+			open = -1
+		}
+		pos += len(line)
+	}
+	if open != -1 {
+		ranges = append(ranges, [2]int{open, len(text)})
+	}
+
+	for _, m := range inlineCode.FindAllStringIndex(text, -1) {
+		if !inRanges(ranges, m[0]) {
+			ranges = append(ranges, [2]int{m[0], m[1]})
+		}
+	}
+
+	return ranges
+}
+
+func inRanges(ranges [][2]int, pos int) bool {
+	for _, r := range ranges {
+		if pos >= r[0] && pos < r[1] {
+			return true
+		}
+	}
+	return false
+}
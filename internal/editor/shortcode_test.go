@@ -0,0 +1,85 @@
+package editor
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestExpandShortcodes(t *testing.T) {
+	err := os.MkdirAll("testdata/docs/assets", 0755)
+	if err != nil {
+		t.Fatalf("Error creating assets dir: %s", err)
+	}
+	err = os.WriteFile("testdata/docs/assets/points.txt", []byte(strings.Repeat("x", 2048)), 0644)
+	if err != nil {
+		t.Fatalf("Error writing asset: %s", err)
+	}
+	t.Cleanup(func() { os.RemoveAll("testdata/docs/assets") })
+
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{
+			name: "figure with named params",
+			body: `{{< figure src="/img/a.png" caption="A \"quoted\" caption" >}}`,
+			want: []string{
+				`<img src="/img/a.png" alt="A &#34;quoted&#34; caption" />`,
+				`<figcaption>A &#34;quoted&#34; caption</figcaption>`,
+			},
+		},
+		{
+			name: "note with markdown and nested shortcode",
+			body: "{{< note >}}\nSome **bold** text.\n\n{{< figure src=b.png >}}\n{{< /note >}}\n",
+			want: []string{
+				`<aside class="callout callout-note">`,
+				"<strong>bold</strong>",
+				`<img src="b.png" alt="" />`,
+				"</aside>",
+			},
+		},
+		{
+			name: "file with positional argument",
+			body: `Get it {{< file "points.txt" >}}.`,
+			want: []string{`Get it <a class="file" href="/assets/points.txt" download>points.txt</a> (2.0 KB).`},
+		},
+		{
+			name: "code is left alone",
+			body: "Use `{{< note >}}`.\n\n```\n{{< figure src=a.png >}}\n```\n",
+			want: []string{"Use `{{< note >}}`.", "```\n{{< figure src=a.png >}}\n```"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandShortcodes([]byte(tt.body), newRenderer(), "")
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			for _, w := range tt.want {
+				if !strings.Contains(string(got), w) {
+					t.Errorf("Expected %q in\n%s", w, got)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandShortcodesErrors(t *testing.T) {
+	tests := map[string]string{
+		"text\n\n{{< youtube id=1 >}}": `line 3: unknown shortcode "youtube"`,
+		"{{< /note >}}":                `line 1: unexpected closing shortcode "note"`,
+		`{{< figure src="a.png >}}`:    "unterminated quoted argument",
+		`{{< file "missing.txt" >}}`:   `asset "missing.txt"`,
+	}
+
+	for body, want := range tests {
+		_, err := expandShortcodes([]byte(body), newRenderer(), "")
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%q: want error containing %q, got %v", body, want, err)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package editor
+
+import (
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Snippet is code read from a file, included in an entry with the `include` shortcode.
+type Snippet struct {
+	Lang string
+	// Code is the raw code, it is not escaped as it goes into a fenced code block.
+	Code template.HTML
+	// Fence is longer than any run of backticks in the code.
+	Fence string
+}
+
+// extLangs maps file extensions to the language of their code blocks.
+var extLangs = map[string]string{
+	".go":   "go",
+	".ts":   "typescript",
+	".tsx":  "typescript",
+	".js":   "javascript",
+	".rs":   "rust",
+	".sql":  "sql",
+	".sh":   "sh",
+	".mk":   "makefile",
+	".json": "json",
+}
+
+// regionMarker matches the comment lines around a named region, e.g. `// region: worker`
+// and `// endregion: worker`, with `//`, `#` or `--` comments.
+var regionMarker = regexp.MustCompile(`^\s*(?://|#|--)\s*(end)?region:\s*([\w-]+)\s*$`)
+
+// snippet reads the code in `path`, optionally only the `lines` range (e.g. "10-40")
+// or the named `region`, without its start and end markers.
+func snippet(path string, lines string, region string) (Snippet, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return Snippet{}, fmt.Errorf("snippet: %w", err)
+	}
+	code := strings.Split(strings.TrimRight(string(content), "\n"), "\n")
+
+	if lines != "" {
+		code, err = lineRange(code, lines)
+		if err != nil {
+			return Snippet{}, fmt.Errorf("snippet %s: %w", path, err)
+		}
+	}
+	if region != "" {
+		code, err = regionLines(code, region)
+		if err != nil {
+			return Snippet{}, fmt.Errorf("snippet %s: %w", path, err)
+		}
+	}
+
+	text := strings.Join(dedent(code), "\n")
+
+	lang := extLangs[filepath.Ext(path)]
+	if base := filepath.Base(path); base == "Makefile" || base == "makefile" {
+		lang = "makefile"
+	}
+
+	fence := "```"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+
+	return Snippet{Lang: lang, Code: template.HTML(text), Fence: fence}, nil
+}
+
+// lineRange returns the lines in the 1-based, inclusive range `spec`: "10-40", "10-" or "10".
+func lineRange(code []string, spec string) ([]string, error) {
+	from, to, found := strings.Cut(spec, "-")
+
+	start, err := strconv.Atoi(strings.TrimSpace(from))
+	if err != nil {
+		return nil, fmt.Errorf("invalid lines %q", spec)
+	}
+	end := start
+	if found {
+		end = len(code)
+		if strings.TrimSpace(to) != "" {
+			end, err = strconv.Atoi(strings.TrimSpace(to))
+			if err != nil {
+				return nil, fmt.Errorf("invalid lines %q", spec)
+			}
+		}
+	}
+
+	if start < 1 || end < start || end > len(code) {
+		return nil, fmt.Errorf("lines %q out of range, the file has %d lines", spec, len(code))
+	}
+
+	return code[start-1 : end], nil
+}
+
+// regionLines returns the lines between the start and end markers of `name`.
+func regionLines(code []string, name string) ([]string, error) {
+	start := -1
+	for i, line := range code {
+		m := regionMarker.FindStringSubmatch(line)
+		if m == nil || m[2] != name {
+			continue
+		}
+		if m[1] == "" && start == -1 {
+			start = i + 1
+		} else if m[1] == "end" && start != -1 {
+			return code[start:i], nil
+		}
+	}
+
+	if start == -1 {
+		return nil, fmt.Errorf("region %q not found", name)
+	}
+	return nil, fmt.Errorf("region %q has no end marker", name)
+}
+
+// dedent removes the indentation common to all non-blank lines.
+func dedent(lines []string) []string {
+	prefix, found := "", false
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if !found {
+			prefix, found = indent, true
+			continue
+		}
+		for !strings.HasPrefix(indent, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		out = append(out, strings.TrimPrefix(line, prefix))
+	}
+	return out
+}
@@ -0,0 +1,136 @@
+package editor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const snippetSource = `package main
+
+import "fmt"
+
+func main() {
+	// region: greet
+	name := "gopher"
+	fmt.Println("hi", name)
+	// endregion: greet
+}
+`
+
+func TestIncludeSnippet(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "main.go")
+	err := os.WriteFile(file, []byte(snippetSource), 0644)
+	if err != nil {
+		t.Fatalf("Error writing snippet: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		args string
+		want string
+	}{
+		{
+			name: "whole file",
+			args: "",
+			want: "```go\npackage main\n\nimport \"fmt\"\n\nfunc main() {\n\t// region: greet\n\tname := \"gopher\"\n\tfmt.Println(\"hi\", name)\n\t// endregion: greet\n}\n```",
+		},
+		{
+			name: "line range",
+			args: `lines="3-5"`,
+			want: "```go\nimport \"fmt\"\n\nfunc main() {\n```",
+		},
+		{
+			name: "region is dedented",
+			args: `region="greet" lang="golang"`,
+			want: "```golang\nname := \"gopher\"\nfmt.Println(\"hi\", name)\n```",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandShortcodes([]byte(`{{< include "`+file+`" `+tt.args+` >}}`), newRenderer(), "")
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("want\n%s\ngot\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestIncludeSnippetKeepsRegionKeys(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	content := "name: app\n# region: aws\naws:\n  region: eu-west-1\n# endregion: aws\n"
+	err := os.WriteFile(file, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Error writing snippet: %s", err)
+	}
+
+	tests := map[string]string{
+		"":             "```\nname: app\n# region: aws\naws:\n  region: eu-west-1\n# endregion: aws\n```",
+		`region="aws"`: "```\naws:\n  region: eu-west-1\n```",
+	}
+
+	for args, want := range tests {
+		got, err := expandShortcodes([]byte(`{{< include "`+file+`" `+args+` >}}`), newRenderer(), "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if string(got) != want {
+			t.Errorf("%s: want\n%s\ngot\n%s", args, want, got)
+		}
+	}
+}
+
+func TestIncludeSnippetErrors(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "main.go")
+	err := os.WriteFile(file, []byte(snippetSource), 0644)
+	if err != nil {
+		t.Fatalf("Error writing snippet: %s", err)
+	}
+
+	tests := map[string]string{
+		`"missing.go"`:                  "no such file",
+		`"` + file + `" lines="8-20"`:   `lines "8-20" out of range, the file has 10 lines`,
+		`"` + file + `" region="other"`: `region "other" not found`,
+	}
+
+	for args, want := range tests {
+		_, err := expandShortcodes([]byte("{{< include "+args+" >}}"), newRenderer(), "")
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: want error containing %q, got %v", args, want, err)
+		}
+	}
+}
+
+func TestIncludeSnippetIsHighlighted(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "query.sql")
+	err := os.WriteFile(file, []byte("SELECT '<b>';\n"), 0644)
+	if err != nil {
+		t.Fatalf("Error writing snippet: %s", err)
+	}
+
+	html, err := ToHTML([]byte(`{{< include "`+file+`" >}}`), "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	want := `<pre><code class="language-sql"><span class="hl-keyword">SELECT</span> <span class="hl-string">&#39;&lt;b&gt;&#39;</span>;`
+	if !strings.Contains(string(html), want) {
+		t.Errorf("Expected %q in\n%s", want, html)
+	}
+}
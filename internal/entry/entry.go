@@ -2,6 +2,7 @@ package entry
 
 import (
 	"errors"
+	"fmt"
 	"html/template"
 	"strings"
 	"time"
@@ -13,78 +14,121 @@ const (
 )
 
 type HtmlEntry struct {
+	// Filename is the slug of the entry, used for its page and links.
 	Filename  string
 	Title     string
 	Published string
 	Revision  string
 	Tags      []string
+	Authors   []string
 	Excerpt   string
+	Cover     string
 	Body      template.HTML
+	// PublishedISO and RevisionISO are the dates in RFC3339 format, for metadata.
+	PublishedISO string
+	RevisionISO  string
+	// URL, Image and JSONLD are set when rendering the page, as they depend on the site.
+	URL    string
+	Image  string
+	JSONLD template.JS
+	// TOC is the table of contents, only set for entries with `toc: true`.
+	TOC []*Heading
+	// WordCount and ReadingTime, in minutes, are set by Measure.
+	WordCount   int
+	ReadingTime int
 }
 
+// Heading is a section of an entry, linked from the table of contents.
+type Heading struct {
+	ID       string
+	Title    string
+	Level    int
+	Children []*Heading
+}
+
+// MdEntry is used to pre-populate the front matter of a new draft.
 type MdEntry struct {
-	Title     string
-	Published string
-	Revision  string
-	Tags      string // comma separated
-	Excerpt   string
+	FrontMatter
 }
 
 // NewMdEntry creates a new Markdown entry using the current date.
 func NewMdEntry(title string) *MdEntry {
 	date := time.Now().Format(InputDateFormat)
 	return &MdEntry{
-		Title:     title,
-		Excerpt:   "",
-		Published: date,
-		Revision:  date,
-		Tags:      "",
+		FrontMatter: FrontMatter{
+			Title:     title,
+			Slug:      Slugify(title),
+			Excerpt:   "",
+			Published: date,
+			Revision:  date,
+			Tags:      []string{},
+		},
 	}
 }
 
 // NewHtmlEntry creates a new HTML entry taking a front matter as input.
-// Title is capitalized and "-" replaced with spaces.
-func NewHtmlEntry(fm map[string]string) (*HtmlEntry, error) {
+// When a slug is given, the title is used verbatim.
+// Otherwise, the title doubles as slug and, for display,
+// it is capitalized and "-" replaced with spaces.
+func NewHtmlEntry(fm *FrontMatter) (*HtmlEntry, error) {
 	e := &HtmlEntry{}
 
-	published, ok := fm["published"]
-	if !ok {
+	if !fm.Has("published") {
 		return nil, errors.New("missing publish date in front matter")
 	}
-	formattedPublished, err := FormatDate(published)
+	formattedPublished, err := FormatDate(fm.Published)
 	if err != nil {
 		return nil, err
 	}
 	e.Published = formattedPublished
+	e.PublishedISO, err = isoDate(fm.Published)
+	if err != nil {
+		return nil, err
+	}
 
-	revision, ok := fm["revision"]
-	if !ok {
+	if !fm.Has("revision") {
 		return nil, errors.New("missing revision date in front matter")
 	}
-	formattedRevision, err := FormatDate(revision)
+	formattedRevision, err := FormatDate(fm.Revision)
 	if err != nil {
 		return nil, err
 	}
 	e.Revision = formattedRevision
+	e.RevisionISO, err = isoDate(fm.Revision)
+	if err != nil {
+		return nil, err
+	}
 
-	title, ok := fm["title"]
-	if !ok {
+	if !fm.Has("title") {
 		return nil, errors.New("missing title in front matter")
 	}
-	e.Filename = title
-	e.Title = parseTitle(title)
+	if fm.Slug != "" && fm.Slug != Slugify(fm.Slug) {
+		return nil, fmt.Errorf("slug %q is not URL safe, try %q", fm.Slug, Slugify(fm.Slug))
+	}
+	if fm.Slug != "" {
+		e.Filename = fm.Slug
+		e.Title = fm.Title
+	} else {
+		e.Filename = fm.Title
+		e.Title = parseTitle(fm.Title)
+	}
+	if IsReservedSlug(e.Filename) {
+		return nil, fmt.Errorf("slug %q is reserved for the site", e.Filename)
+	}
 
-	excerpt, ok := fm["excerpt"]
-	if !ok {
+	if !fm.Has("excerpt") {
 		return nil, errors.New("missing excerpt in front matter")
 	}
-	e.Excerpt = excerpt
+	e.Excerpt = fm.Excerpt
+	e.Cover = fm.Cover
 
-	tags, ok := fm["tags"]
-	if !ok {
+	e.Tags = fm.Tags
+	if e.Tags == nil {
 		e.Tags = []string{}
-	} else {
-		e.Tags = strings.Split(tags, ",")
+	}
+	e.Authors = fm.Authors
+	if e.Authors == nil {
+		e.Authors = []string{}
 	}
 
 	return e, nil
@@ -98,9 +142,20 @@ func FormatDate(dateStr string) (string, error) {
 	return parsed.Format(OutputDateFormat), nil
 }
 
+func isoDate(dateStr string) (string, error) {
+	parsed, err := time.Parse(InputDateFormat, dateStr)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Format(time.RFC3339), nil
+}
+
 func parseTitle(title string) string {
 	capitalized := []string{}
 	for _, w := range strings.Split(title, "-") {
+		if w == "" {
+			continue
+		}
 		capitalized = append(capitalized, strings.ToUpper(string(w[0]))+string(w[1:]))
 	}
 	return strings.Join(capitalized, " ")
@@ -3,6 +3,8 @@ package entry
 import (
 	"errors"
 	"fmt"
+	"html/template"
+	"strings"
 	"testing"
 	"time"
 )
@@ -29,12 +31,12 @@ func TestNewHTMLEntry(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		input  map[string]string
+		input  map[string]any
 		output *HtmlEntry
 		err    error
 	}{
 		{
-			input: map[string]string{
+			input: map[string]any{
 				"revision": "",
 				"title":    "",
 				"excerpt":  "",
@@ -43,7 +45,7 @@ func TestNewHTMLEntry(t *testing.T) {
 			err:    errors.New("missing publish date in front matter"),
 		},
 		{
-			input: map[string]string{
+			input: map[string]any{
 				"published": "bad-date",
 				"revision":  "",
 				"title":     "",
@@ -53,7 +55,7 @@ func TestNewHTMLEntry(t *testing.T) {
 			err:    errors.New("parsing time \"bad-date\" as \"2006-01-02\": cannot parse \"bad-date\" as \"2006\""),
 		},
 		{
-			input: map[string]string{
+			input: map[string]any{
 				"published": "1987-08-06",
 				"title":     "",
 				"excerpt":   "",
@@ -62,7 +64,7 @@ func TestNewHTMLEntry(t *testing.T) {
 			err:    errors.New("missing revision date in front matter"),
 		},
 		{
-			input: map[string]string{
+			input: map[string]any{
 				"published": "1987-08-06",
 				"revision":  "1987-08-06",
 				"excerpt":   "",
@@ -71,7 +73,7 @@ func TestNewHTMLEntry(t *testing.T) {
 			err:    errors.New("missing title in front matter"),
 		},
 		{
-			input: map[string]string{
+			input: map[string]any{
 				"published": "1987-08-06",
 				"revision":  "1987-08-06",
 				"title":     "a-title",
@@ -80,7 +82,7 @@ func TestNewHTMLEntry(t *testing.T) {
 			err:    errors.New("missing excerpt in front matter"),
 		},
 		{
-			input: map[string]string{
+			input: map[string]any{
 				"published": "1987-bad-06",
 				"revision":  "1987-08-06",
 				"title":     "a-title",
@@ -90,7 +92,7 @@ func TestNewHTMLEntry(t *testing.T) {
 			err:    errors.New("parsing time \"1987-bad-06\" as \"2006-01-02\": cannot parse \"bad-06\" as \"01\""),
 		},
 		{
-			input: map[string]string{
+			input: map[string]any{
 				"published": "1987-08-06",
 				"revision":  "1987-08-06",
 				"title":     "a-title-foo-bar",
@@ -105,18 +107,169 @@ func TestNewHTMLEntry(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			input: map[string]any{
+				"published": "1987-08-06",
+				"revision":  "1987-08-06",
+				"title":     "a--title-",
+				"excerpt":   "blah blah blah",
+			},
+			output: &HtmlEntry{
+				Filename:  "a--title-",
+				Published: "August 6, 1987",
+				Revision:  "August 6, 1987",
+				Title:     "A Title",
+				Excerpt:   "blah blah blah",
+			},
+			err: nil,
+		},
+		{
+			input: map[string]any{
+				"published": "1987-08-06",
+				"revision":  "1987-08-06",
+				"title":     "Secrets in TS: a Go-like approach",
+				"slug":      "secrets-in-ts",
+				"excerpt":   "blah blah blah",
+			},
+			output: &HtmlEntry{
+				Filename:  "secrets-in-ts",
+				Published: "August 6, 1987",
+				Revision:  "August 6, 1987",
+				Title:     "Secrets in TS: a Go-like approach",
+				Excerpt:   "blah blah blah",
+			},
+			err: nil,
+		},
+		{
+			input: map[string]any{
+				"published": "1987-08-06",
+				"revision":  "1987-08-06",
+				"title":     "a-title",
+				"slug":      "../../a-title",
+				"excerpt":   "blah blah blah",
+			},
+			output: nil,
+			err:    errors.New("slug \"../../a-title\" is not URL safe, try \"a-title\""),
+		},
+		{
+			input: map[string]any{
+				"published": "1987-08-06",
+				"revision":  "1987-08-06",
+				"title":     "Images",
+				"slug":      "img",
+				"excerpt":   "blah blah blah",
+			},
+			output: nil,
+			err:    errors.New("slug \"img\" is reserved for the site"),
+		},
 	}
 
 	for i, tt := range tests {
 		testname := fmt.Sprintf("test#%d", i)
 		t.Run(testname, func(t *testing.T) {
-			htmlEntry, err := NewHtmlEntry(tt.input)
+			fm, err := NewFrontMatter(tt.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			htmlEntry, err := NewHtmlEntry(fm)
 			cmpHtmlEntries(t, htmlEntry, tt.output)
 			cmpErrors(t, err, tt.err)
 		})
 	}
 }
 
+func TestNewFrontMatterPublishAt(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input any
+		want  time.Time
+		err   bool
+	}{
+		{input: nil, want: time.Time{}},
+		{input: "2024-03-01T10:30:00Z", want: time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)},
+		{input: "2024-03-01 10:30", want: time.Date(2024, 3, 1, 10, 30, 0, 0, time.Local)},
+		{input: "2024-03-01", want: time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)},
+		{input: "tomorrow", err: true},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test#%d", i), func(t *testing.T) {
+			fm, err := NewFrontMatter(map[string]any{"publish_at": tt.input})
+			if tt.err {
+				if err == nil {
+					t.Error("want error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !fm.PublishAt.Equal(tt.want) {
+				t.Errorf("want publish_at %s, got %s", tt.want, fm.PublishAt)
+			}
+		})
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"my-test-entry", "my-test-entry"},
+		{"Secrets in TS: a Go-like approach!", "secrets-in-ts-a-go-like-approach"},
+		{"  a--b  ", "a-b"},
+		{"Año de programación", "ano-de-programacion"},
+		{"Straße & Œuvre", "strasse-oeuvre"},
+		{"日本語", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := Slugify(tt.input)
+			if got != tt.want {
+				t.Errorf("want slug %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMeasure(t *testing.T) {
+	t.Parallel()
+
+	prose := "<p>" + strings.Repeat("word ", 460) + "</p>\n"
+	code := `<pre><code class="language-go">` + strings.Repeat(`x := <span class="hl-number">1</span>`+"\n", 80) + "</code></pre>\n"
+
+	tests := []struct {
+		name        string
+		body        string
+		wordCount   int
+		readingTime int
+	}{
+		{"empty", "", 0, 1},
+		{"prose", prose, 460, 2},
+		{"links and images", `<p>See <a href="https://go.dev/doc/" title="Go docs">the docs</a> and <img src="/assets/chart.png" alt="a chart" />.</p>`, 4, 1},
+		{"code", prose + code, 460, 4},
+		{"markup", "<h2 id=\"usage\">Usage <a class=\"anchor\" href=\"#usage\" aria-hidden=\"true\">#</a></h2>\n<ul>\n<li>one &amp; two</li>\n</ul>\n<table><tr><td>three</td></tr></table>", 4, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &HtmlEntry{}
+			e.Measure(template.HTML(tt.body))
+			if e.WordCount != tt.wordCount {
+				t.Errorf("want %d words, got %d", tt.wordCount, e.WordCount)
+			}
+			if e.ReadingTime != tt.readingTime {
+				t.Errorf("want %d minutes, got %d", tt.readingTime, e.ReadingTime)
+			}
+		})
+	}
+}
+
 func cmpHtmlEntries(t *testing.T, got, want *HtmlEntry) {
 	t.Helper()
 
@@ -0,0 +1,199 @@
+package entry
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FrontMatter holds the metadata at the top of an entry.
+type FrontMatter struct {
+	Title     string
+	Slug      string
+	Published string
+	Revision  string
+	Tags      []string
+	Authors   []string
+	Excerpt   string
+	Cover     string
+	TOC       bool
+	PublishAt time.Time
+	// keys keeps track of the fields found in the source,
+	// to tell apart a missing field from an empty one.
+	keys map[string]bool
+}
+
+// knownKeys are the front matter fields understood by NewFrontMatter.
+var knownKeys = map[string]bool{
+	"title":      true,
+	"slug":       true,
+	"published":  true,
+	"revision":   true,
+	"tags":       true,
+	"authors":    true,
+	"excerpt":    true,
+	"cover":      true,
+	"toc":        true,
+	"publish_at": true,
+}
+
+// IsKnownKey reports whether `key` is a front matter field understood by NewFrontMatter.
+func IsKnownKey(key string) bool {
+	return knownKeys[key]
+}
+
+// NewFrontMatter normalizes the raw key-value pairs decoded from
+// the front matter of an entry into a FrontMatter.
+func NewFrontMatter(raw map[string]any) (*FrontMatter, error) {
+	fm := &FrontMatter{keys: make(map[string]bool)}
+
+	for key, value := range raw {
+		fm.keys[key] = true
+
+		var err error
+		switch key {
+		case "title":
+			fm.Title, err = toString(value)
+		case "slug":
+			fm.Slug, err = toString(value)
+		case "published":
+			fm.Published, err = toString(value)
+		case "revision":
+			fm.Revision, err = toString(value)
+		case "tags":
+			fm.Tags, err = toList(value)
+		case "authors":
+			fm.Authors, err = toList(value)
+		case "excerpt":
+			fm.Excerpt, err = toString(value)
+		case "cover":
+			fm.Cover, err = toString(value)
+		case "toc":
+			fm.TOC, err = toBool(value)
+		case "publish_at":
+			fm.PublishAt, err = toTime(value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %q in front matter: %w", key, err)
+		}
+	}
+
+	return fm, nil
+}
+
+// Keys returns the fields found in the source, sorted alphabetically.
+func (fm *FrontMatter) Keys() []string {
+	keys := make([]string, 0, len(fm.keys))
+	for key := range fm.keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Has reports whether the front matter contains the given key, even if empty.
+func (fm *FrontMatter) Has(key string) bool {
+	return fm.keys[key]
+}
+
+func toString(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return strings.TrimSpace(v), nil
+	case time.Time:
+		if v.Hour() == 0 && v.Minute() == 0 && v.Second() == 0 {
+			return v.Format(InputDateFormat), nil
+		}
+		return v.Format(time.RFC3339), nil
+	case bool, int, int64, float64:
+		return fmt.Sprint(v), nil
+	default:
+		return "", fmt.Errorf("expected a string, got %T", value)
+	}
+}
+
+// toList accepts either a list or a comma separated string.
+func toList(value any) ([]string, error) {
+	items := []string{}
+
+	switch v := value.(type) {
+	case nil:
+		return items, nil
+	case string:
+		for _, item := range strings.Split(v, ",") {
+			item = strings.TrimSpace(item)
+			if item != "" {
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	case []string:
+		return toList(strings.Join(v, ","))
+	case []any:
+		for _, elem := range v {
+			item, err := toString(elem)
+			if err != nil {
+				return nil, err
+			}
+			if item != "" {
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("expected a list, got %T", value)
+	}
+}
+
+func toBool(value any) (bool, error) {
+	switch v := value.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return false, nil
+		}
+		return strconv.ParseBool(strings.TrimSpace(v))
+	default:
+		return false, fmt.Errorf("expected a boolean, got %T", value)
+	}
+}
+
+// timeLayouts are the accepted formats for timestamps, in local time unless specified.
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	InputDateFormat,
+}
+
+func toTime(value any) (time.Time, error) {
+	switch v := value.(type) {
+	case nil:
+		return time.Time{}, nil
+	case time.Time:
+		return v, nil
+	case string:
+		v = strings.TrimSpace(v)
+		if v == "" {
+			return time.Time{}, nil
+		}
+		for _, layout := range timeLayouts {
+			t, err := time.ParseInLocation(layout, v, time.Local)
+			if err == nil {
+				return t, nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("%q is not a valid timestamp", v)
+	default:
+		return time.Time{}, fmt.Errorf("expected a timestamp, got %T", value)
+	}
+}
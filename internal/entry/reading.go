@@ -0,0 +1,63 @@
+package entry
+
+import (
+	"html"
+	"html/template"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const (
+	// WordsPerMinute is the reading speed of prose.
+	WordsPerMinute = 230
+	// CodeWordsPerMinute is the reading speed of code blocks, slower than prose.
+	CodeWordsPerMinute = 120
+)
+
+var (
+	preBlock = regexp.MustCompile(`(?s)<pre[\s>].*?</pre>`)
+	htmlTag  = regexp.MustCompile(`<[^>]*>`)
+)
+
+// Measure sets the word count and reading time of the entry from its rendered body,
+// so markdown syntax and shortcodes are not counted.
+// Words in code blocks are not counted either, but they add to the reading time
+// at CodeWordsPerMinute.
+func (e *HtmlEntry) Measure(body template.HTML) {
+	prose, code := countWords(string(body))
+	e.WordCount = prose
+
+	minutes := float64(prose)/WordsPerMinute + float64(code)/CodeWordsPerMinute
+	e.ReadingTime = int(minutes + 0.5)
+	if e.ReadingTime < 1 {
+		e.ReadingTime = 1
+	}
+}
+
+// countWords returns the number of words outside and inside <pre> blocks.
+// Outside code, tokens without letters or digits, like the `#` of heading anchors, are skipped.
+func countWords(body string) (int, int) {
+	code := 0
+	for _, block := range preBlock.FindAllString(body, -1) {
+		code += len(strings.Fields(plainText(block)))
+	}
+
+	prose := 0
+	for _, word := range strings.Fields(plainText(preBlock.ReplaceAllString(body, " "))) {
+		if strings.IndexFunc(word, isWordRune) != -1 {
+			prose++
+		}
+	}
+
+	return prose, code
+}
+
+// plainText removes the tags of an HTML fragment and unescapes its entities.
+func plainText(fragment string) string {
+	return html.UnescapeString(htmlTag.ReplaceAllString(fragment, " "))
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
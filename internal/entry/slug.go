@@ -0,0 +1,53 @@
+package entry
+
+import (
+	"strings"
+	"unicode"
+)
+
+// transliterations maps common non-ASCII letters to their ASCII counterparts.
+var transliterations = map[rune]string{
+	'á': "a", 'à': "a", 'â': "a", 'ä': "a", 'ã': "a", 'å': "a",
+	'é': "e", 'è': "e", 'ê': "e", 'ë': "e",
+	'í': "i", 'ì': "i", 'î': "i", 'ï': "i",
+	'ó': "o", 'ò': "o", 'ô': "o", 'ö': "o", 'õ': "o", 'ø': "o",
+	'ú': "u", 'ù': "u", 'û': "u", 'ü': "u",
+	'ñ': "n", 'ç': "c", 'ý': "y", 'ÿ': "y",
+	'ß': "ss", 'æ': "ae", 'œ': "oe",
+}
+
+// Slugify turns a free-form title into a slug safe to be used
+// as a filename and URL: lowercase ASCII letters, digits and single "-".
+func Slugify(title string) string {
+	var b strings.Builder
+	pendingDash := false
+
+	for _, r := range strings.ToLower(title) {
+		var chunk string
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			chunk = string(r)
+		} else if t, ok := transliterations[r]; ok {
+			chunk = t
+		} else {
+			pendingDash = true
+			continue
+		}
+
+		if pendingDash && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		pendingDash = false
+		b.WriteString(chunk)
+	}
+
+	return b.String()
+}
+
+// reservedSlugs are the names in `blog/` used by the site itself,
+// like the resized images in `blog/img/`, so entries cannot take them.
+var reservedSlugs = map[string]bool{"img": true}
+
+// IsReservedSlug reports whether `slug` cannot be used as the name of an entry.
+func IsReservedSlug(slug string) bool {
+	return reservedSlugs[slug]
+}
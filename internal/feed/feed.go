@@ -1,11 +1,15 @@
 package feed
 
 import (
-	"path/filepath"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
 	"strings"
-	"text/template"
 	"time"
 
+	"germandv.xyz/internal/config"
 	"germandv.xyz/internal/editor"
 	"germandv.xyz/internal/entry"
 	"germandv.xyz/internal/filer"
@@ -16,74 +20,240 @@ type Item struct {
 	Link        string
 	Description string
 	Created     string
+	Published   string // RFC3339
+	Updated     string // RFC3339
+	Authors     []string
+	Tags        []string
+	Content     string // rendered HTML body
+	WordCount   int
+	ReadingTime int // minutes
+	published   time.Time
+	revision    time.Time
 }
 
 type Feed struct {
 	Title       string
 	Link        string
+	RSSLink     string
+	AtomLink    string
+	JSONLink    string
 	Description string
-	LastBuild   string
+	LastBuild   string // RFC822, newest revision among items
+	Updated     string // RFC3339, newest revision among items
 	Lang        string
+	Author      string
+	FullContent bool
 	Items       []Item
 }
 
-// Generate creates a `feed.rss` file with all entries.
-func Generate() error {
-	feed := Feed{
-		Title:       "germandv",
-		Link:        "https://germandv.me",
-		Description: "Programming things",
-		LastBuild:   time.Now().Format(time.RFC3339),
-		Lang:        "en-us",
-		Items:       []Item{},
+// site provides the metadata and base URL of the feeds.
+var site = config.Default()
+
+// Configure sets the site configuration used to generate the feeds.
+func Configure(s *config.Site) {
+	site = s
+}
+
+// Options tweak the generated feeds.
+type Options struct {
+	// Limit is the maximum number of items in the feeds, 0 means no limit.
+	Limit int
+	// FullContent includes the rendered body of the entries in the RSS and Atom feeds,
+	// not just the excerpt. The JSON feed always includes it.
+	FullContent bool
+}
+
+// Generate creates a `feed.xml` (RSS), an `atom.xml` (Atom)
+// and a `feed.json` (JSON Feed) file with all entries, newest first,
+// plus RSS and Atom feeds for each tag.
+// Dates are taken from the entries, so regenerating without changes
+// yields identical files.
+func Generate(opts Options) error {
+	items, err := readItems()
+	if err != nil {
+		return err
 	}
 
-	files, err := filer.ListPublished()
+	feed := newFeed(items, opts)
+	feed.Title = site.Title
+	feed.Link = site.BaseURL
+	feed.RSSLink = site.URL(site.BlogPath + "feed.xml")
+	feed.AtomLink = site.URL(site.BlogPath + "atom.xml")
+	feed.JSONLink = site.URL(site.BlogPath + "feed.json")
+
+	err = filer.WriteTemplate(feed, "feed.xml", "feed", filer.CreateFeed)
 	if err != nil {
 		return err
 	}
 
-	for _, file := range files {
-		frontMatter, _, err := editor.ParseMd(file)
+	err = filer.WriteTemplate(feed, "atom.xml", "atom", filer.CreateAtomFeed)
+	if err != nil {
+		return err
+	}
+
+	err = writeJSON(feed)
+	if err != nil {
+		return err
+	}
+
+	return generateTagFeeds(items, opts)
+}
+
+// generateTagFeeds creates `tags/<tag>/feed.xml` and `tags/<tag>/atom.xml`
+// with the entries of each tag.
+func generateTagFeeds(items []Item, opts Options) error {
+	byTag := make(map[string][]Item)
+	for _, item := range items {
+		for _, tag := range item.Tags {
+			byTag[tag] = append(byTag[tag], item)
+		}
+	}
+
+	err := filer.ResetTagFeeds()
+	if err != nil {
+		return err
+	}
+
+	for tag, tagged := range byTag {
+		tag := tag
+		feed := newFeed(tagged, opts)
+		feed.Title = site.Title + ": " + tag
+		feed.Link = site.URL("/tags/" + tag + ".html")
+		feed.RSSLink = site.URL("/tags/" + tag + "/feed.xml")
+		feed.AtomLink = site.URL("/tags/" + tag + "/atom.xml")
+
+		err = filer.WriteTemplate(feed, "feed.xml", "feed", func() (*os.File, error) {
+			return filer.CreateTagFeed(tag, "feed.xml")
+		})
 		if err != nil {
 			return err
 		}
 
-		art, err := entry.NewHtmlEntry(frontMatter)
+		err = filer.WriteTemplate(feed, "atom.xml", "atom", func() (*os.File, error) {
+			return filer.CreateTagFeed(tag, "atom.xml")
+		})
 		if err != nil {
 			return err
 		}
+	}
 
-		feed.Items = append(feed.Items, Item{
-			Title:       art.Title,
-			Link:        getLink(file),
-			Description: art.Excerpt,
-			Created:     art.Published,
-		})
+	return nil
+}
+
+// newFeed creates a feed with the given items, which must be sorted newest first,
+// taking the build dates from the newest revision among them.
+func newFeed(items []Item, opts Options) Feed {
+	if opts.Limit > 0 && len(items) > opts.Limit {
+		items = items[:opts.Limit]
 	}
 
-	tmpl, err := template.ParseFiles(filepath.Join("templates", "feed.xml"))
-	if err != nil {
-		return err
+	var newest time.Time
+	for _, item := range items {
+		if item.revision.After(newest) {
+			newest = item.revision
+		}
 	}
 
-	f, err := filer.CreateFeed()
-	if err != nil {
-		return err
+	description := site.FeedDescription
+	if description == "" {
+		description = site.Description
+	}
+
+	return Feed{
+		Description: description,
+		LastBuild:   newest.Format(time.RFC1123Z),
+		Updated:     newest.Format(time.RFC3339),
+		Lang:        site.Language,
+		Author:      site.Author,
+		FullContent: opts.FullContent,
+		Items:       items,
 	}
+}
 
-	err = tmpl.ExecuteTemplate(f, "feed", feed)
+// readItems reads all published entries, sorted newest first.
+func readItems() ([]Item, error) {
+	files, err := filer.ListPublished()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	items := []Item{}
+	for _, file := range files {
+		frontMatter, body, err := editor.ParseMd(file)
+		if err != nil {
+			return nil, err
+		}
+
+		art, err := entry.NewHtmlEntry(frontMatter)
+		if err != nil {
+			return nil, err
+		}
+
+		published, err := time.Parse(entry.InputDateFormat, frontMatter.Published)
+		if err != nil {
+			return nil, err
+		}
+		revision, err := time.Parse(entry.InputDateFormat, frontMatter.Revision)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := editor.ToHTML(body, file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		art.Measure(content)
+
+		content = editor.BundleLinks(content, file, art.Filename)
+
+		link := site.PostURL(art.Filename)
+		items = append(items, Item{
+			Title:       art.Title,
+			Link:        link,
+			Description: art.Excerpt,
+			Created:     published.Format(time.RFC1123Z),
+			Published:   published.Format(time.RFC3339),
+			Updated:     revision.Format(time.RFC3339),
+			Authors:     art.Authors,
+			Tags:        art.Tags,
+			Content:     absolutize(string(content), link),
+			WordCount:   art.WordCount,
+			ReadingTime: art.ReadingTime,
+			published:   published,
+			revision:    revision,
+		})
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if !a.published.Equal(b.published) {
+			return a.published.After(b.published)
+		}
+		return a.Link < b.Link
+	})
+
+	return items, nil
 }
 
-func getLink(mdFilepath string) string {
-	baseURL := "https://germandv.me/blog/"
-	parts := strings.Split(mdFilepath, "/")
-	mdFile := parts[len(parts)-1]
-	htmlFile := strings.TrimSuffix(mdFile, ".md") + ".html"
-	return baseURL + htmlFile
+var urlAttr = regexp.MustCompile(`(href|src)="([^"]*)"`)
+
+// absolutize rewrites relative `href` and `src` attributes to absolute URLs,
+// resolved against `pageURL`, so they work inside feed readers.
+func absolutize(html string, pageURL string) string {
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return html
+	}
+
+	return urlAttr.ReplaceAllStringFunc(html, func(attr string) string {
+		parts := urlAttr.FindStringSubmatch(attr)
+		name, value := parts[1], parts[2]
+
+		ref, err := url.Parse(value)
+		if err != nil || ref.IsAbs() || strings.HasPrefix(value, "//") {
+			return attr
+		}
+
+		return fmt.Sprintf(`%s="%s"`, name, base.ResolveReference(ref))
+	})
 }
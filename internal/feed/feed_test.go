@@ -1,48 +1,237 @@
 package feed
 
 import (
-	"fmt"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
+
+	"germandv.xyz/internal/testsite"
 )
 
 func TestMain(m *testing.M) {
-	exitCode := m.Run()
-	teardown()
-	os.Exit(exitCode)
+	testsite.Main(m, "../../")
 }
 
-func teardown() {
-	err := os.Remove("testdata/docs/blog/feed.xml")
+func TestGenerateReadsPagesAndGeneratesRSSFeedFile(t *testing.T) {
+	os.Setenv("SRC", "testdata/entries")
+	os.Setenv("DST", "testdata/docs")
+	err := Generate(Options{})
 	if err != nil {
-		fmt.Println("Error removing file generated by test!", err)
+		t.Errorf("Unexpected error: %s", err)
 	}
+
+	readAndValidateFeed(t, "testdata/docs/blog", "feed.xml")
+	readAndValidateFeed(t, "testdata/docs/blog", "atom.xml")
+	readAndValidateJSONFeed(t, "testdata/docs/blog")
 }
 
-func TestGenerateReadsPagesAndGeneratesRSSFeedFile(t *testing.T) {
-	// Change directory to the root to facilitate access to `templates/`.
-	err := os.Chdir("../../")
+func readAndValidateJSONFeed(t *testing.T, dir string) {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join(dir, "feed.json"))
 	if err != nil {
-		t.Errorf("Error changing directory: %s", err)
+		t.Errorf("Error reading JSON feed: %s", err)
+		return
 	}
 
-	os.Setenv("SRC", "testdata/entries")
-	os.Setenv("DST", "testdata/docs")
-	err = Generate()
+	var feed jsonFeed
+	err = json.Unmarshal(content, &feed)
 	if err != nil {
-		t.Errorf("Unexpected error: %s", err)
+		t.Errorf("Invalid JSON feed: %s", err)
+	}
+	if feed.Version != jsonFeedVersion {
+		t.Errorf("want version %q, got %q", jsonFeedVersion, feed.Version)
+	}
+	if feed.Items == nil {
+		t.Error("want items to be a list, got null")
+	}
+	if feed.Description != "Programming things" {
+		t.Errorf("want the feed description, got %q", feed.Description)
+	}
+}
+
+func TestGenerateIsSortedLimitedAndDeterministic(t *testing.T) {
+	for _, name := range []string{"example-post-one.md", "example-post-two.md"} {
+		content, err := os.ReadFile(filepath.Join("testdata/entries", name))
+		if err != nil {
+			t.Fatalf("Error reading %s: %s", name, err)
+		}
+		published := filepath.Join("testdata/entries/published", name)
+		err = os.WriteFile(published, content, 0644)
+		if err != nil {
+			t.Fatalf("Error writing %s: %s", name, err)
+		}
+		t.Cleanup(func() { os.Remove(published) })
+	}
+
+	err := Generate(Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	first, err := os.ReadFile("testdata/docs/blog/feed.xml")
+	if err != nil {
+		t.Fatalf("Error reading RSS file: %s", err)
+	}
+
+	err = Generate(Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	second, err := os.ReadFile("testdata/docs/blog/feed.xml")
+	if err != nil {
+		t.Fatalf("Error reading RSS file: %s", err)
+	}
+
+	if !bytes.Equal(first, second) {
+		t.Error("Regenerating the feed without changes should yield an identical file")
+	}
+
+	var rss struct {
+		LastBuild string `xml:"channel>lastBuildDate"`
+		Items     []struct {
+			Link    string `xml:"link"`
+			PubDate string `xml:"pubDate"`
+		} `xml:"channel>item"`
+	}
+	err = xml.Unmarshal(first, &rss)
+	if err != nil {
+		t.Fatalf("Invalid XML: %s", err)
+	}
+
+	if rss.LastBuild != "Mon, 24 Oct 2022 00:00:00 +0000" {
+		t.Errorf("want lastBuildDate from newest revision, got %q", rss.LastBuild)
+	}
+	if len(rss.Items) != 2 {
+		t.Fatalf("want 2 items, got %d", len(rss.Items))
+	}
+	if rss.Items[0].PubDate != "Tue, 18 Oct 2022 00:00:00 +0000" {
+		t.Errorf("want newest item first, got %q", rss.Items[0].PubDate)
 	}
 
-	readAndValidateFeed(t, "testdata/docs/blog")
+	err = Generate(Options{Limit: 1})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	limited, err := os.ReadFile("testdata/docs/blog/feed.xml")
+	if err != nil {
+		t.Fatalf("Error reading RSS file: %s", err)
+	}
+	if n := bytes.Count(limited, []byte("<item>")); n != 1 {
+		t.Errorf("want 1 item with limit, got %d", n)
+	}
 }
 
-func readAndValidateFeed(t *testing.T, dir string) {
+func readAndValidateFeed(t *testing.T, dir string, file string) {
 	t.Helper()
 
-	f, err := os.Open(filepath.Join(dir, "feed.xml"))
+	f, err := os.Open(filepath.Join(dir, file))
 	if err != nil {
-		t.Errorf("Error opening RSS file: %s", err)
+		t.Errorf("Error opening %s file: %s", file, err)
+		return
 	}
 	defer f.Close()
+
+	decoder := xml.NewDecoder(f)
+	for {
+		_, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Errorf("Invalid XML in %s: %s", file, err)
+			break
+		}
+	}
+}
+
+func TestGenerateCreatesTagFeeds(t *testing.T) {
+	published := "testdata/entries/published/tagged.md"
+	content := "---\ntitle: tagged\npublished: 2024-03-01\nrevision: 2024-03-01\ntags: [go]\nexcerpt: Tagged.\n---\n\nBody.\n"
+	err := os.WriteFile(published, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Error writing entry: %s", err)
+	}
+	t.Cleanup(func() { os.Remove(published) })
+
+	err = Generate(Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	readAndValidateFeed(t, "testdata/docs/tags/go", "feed.xml")
+	readAndValidateFeed(t, "testdata/docs/tags/go", "atom.xml")
+
+	rss, err := os.ReadFile("testdata/docs/tags/go/feed.xml")
+	if err != nil {
+		t.Fatalf("Error reading tag feed: %s", err)
+	}
+	if !bytes.Contains(rss, []byte("https://germandv.me/tags/go/feed.xml")) {
+		t.Error("Expected tag feed to link to itself")
+	}
+	if !bytes.Contains(rss, []byte("<category>go</category>")) {
+		t.Error("Expected tag feed item to have a category")
+	}
+}
+
+func TestAbsolutize(t *testing.T) {
+	t.Parallel()
+
+	page := "https://germandv.me/blog/go-threadpool.html"
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{`<a href="/assets/polygons.txt">`, `<a href="https://germandv.me/assets/polygons.txt">`},
+		{`<img src="img/diagram.png" alt="">`, `<img src="https://germandv.me/blog/img/diagram.png" alt="">`},
+		{`<a href="../blog.html">`, `<a href="https://germandv.me/blog.html">`},
+		{`<a href="#workers">`, `<a href="https://germandv.me/blog/go-threadpool.html#workers">`},
+		{`<a href="https://debian.org">`, `<a href="https://debian.org">`},
+		{`<img src="//cdn.example.com/a.png">`, `<img src="//cdn.example.com/a.png">`},
+		{`<a href="mailto:me@example.com">`, `<a href="mailto:me@example.com">`},
+	}
+
+	for _, tt := range tests {
+		got := absolutize(tt.input, page)
+		if got != tt.want {
+			t.Errorf("want %s, got %s", tt.want, got)
+		}
+	}
+}
+
+func TestGenerateWithFullContent(t *testing.T) {
+	content, err := os.ReadFile("testdata/entries/example-post-one.md")
+	if err != nil {
+		t.Fatalf("Error reading entry: %s", err)
+	}
+	published := "testdata/entries/published/example-post-one.md"
+	err = os.WriteFile(published, content, 0644)
+	if err != nil {
+		t.Fatalf("Error writing entry: %s", err)
+	}
+	t.Cleanup(func() { os.Remove(published) })
+
+	for _, full := range []bool{false, true} {
+		err = Generate(Options{FullContent: full})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		for _, file := range []string{"feed.xml", "atom.xml"} {
+			readAndValidateFeed(t, "testdata/docs/blog", file)
+			generated, err := os.ReadFile(filepath.Join("testdata/docs/blog", file))
+			if err != nil {
+				t.Fatalf("Error reading %s: %s", file, err)
+			}
+
+			hasContent := bytes.Contains(generated, []byte("Example Post One&lt;/h1&gt;"))
+			if hasContent != full {
+				t.Errorf("%s: want full content %t, got %t", file, full, hasContent)
+			}
+		}
+	}
 }
@@ -0,0 +1,89 @@
+package feed
+
+import (
+	"encoding/json"
+
+	"germandv.xyz/internal/filer"
+)
+
+const jsonFeedVersion = "https://jsonfeed.org/version/1.1"
+
+type jsonAuthor struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type jsonItem struct {
+	ID            string       `json:"id"`
+	URL           string       `json:"url"`
+	Title         string       `json:"title"`
+	ContentHTML   string       `json:"content_html"`
+	Summary       string       `json:"summary,omitempty"`
+	DatePublished string       `json:"date_published"`
+	DateModified  string       `json:"date_modified"`
+	Authors       []jsonAuthor `json:"authors,omitempty"`
+	Tags          []string     `json:"tags,omitempty"`
+	Reading       jsonReading  `json:"_reading"`
+}
+
+// jsonReading is a JSON Feed extension with the length of an item.
+type jsonReading struct {
+	WordCount   int `json:"word_count"`
+	ReadingTime int `json:"reading_time_minutes"`
+}
+
+type jsonFeed struct {
+	Version     string       `json:"version"`
+	Title       string       `json:"title"`
+	HomePageURL string       `json:"home_page_url"`
+	FeedURL     string       `json:"feed_url"`
+	Description string       `json:"description,omitempty"`
+	Language    string       `json:"language,omitempty"`
+	Authors     []jsonAuthor `json:"authors,omitempty"`
+	Items       []jsonItem   `json:"items"`
+}
+
+// writeJSON writes the feed following the JSON Feed 1.1 spec.
+func writeJSON(feed Feed) error {
+	out := jsonFeed{
+		Version:     jsonFeedVersion,
+		Title:       feed.Title,
+		HomePageURL: feed.Link,
+		FeedURL:     feed.JSONLink,
+		Description: feed.Description,
+		Language:    feed.Lang,
+		Authors:     []jsonAuthor{{Name: feed.Author, URL: feed.Link}},
+		Items:       []jsonItem{},
+	}
+
+	for _, item := range feed.Items {
+		authors := []jsonAuthor{}
+		for _, name := range item.Authors {
+			authors = append(authors, jsonAuthor{Name: name})
+		}
+
+		out.Items = append(out.Items, jsonItem{
+			ID:            item.Link,
+			URL:           item.Link,
+			Title:         item.Title,
+			ContentHTML:   item.Content,
+			Summary:       item.Description,
+			DatePublished: item.Published,
+			DateModified:  item.Updated,
+			Authors:       authors,
+			Tags:          item.Tags,
+			Reading:       jsonReading{WordCount: item.WordCount, ReadingTime: item.ReadingTime},
+		})
+	}
+
+	f, err := filer.CreateJSONFeed()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	encoder := json.NewEncoder(f)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(out)
+}
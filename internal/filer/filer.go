@@ -1,9 +1,13 @@
 package filer
 
 import (
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"text/template"
+
+	"germandv.xyz/internal/config"
 )
 
 var src string
@@ -21,6 +25,17 @@ func init() {
 	dst = filepath.Join(indexDst, "blog")
 }
 
+// Configure sets where entries are read from and pages are written to.
+func Configure(site *config.Site) {
+	src = site.EntriesDir
+	indexDst = site.DocsDir
+	dst = filepath.Join(indexDst, site.BlogDir())
+}
+
+// bundleEntry is the markdown file of a page bundle,
+// an entry that is a directory together with its assets.
+const bundleEntry = "index.md"
+
 func list(dir string) (map[uint]string, error) {
 	results := make(map[uint]string)
 	var id uint = 0
@@ -35,12 +50,33 @@ func list(dir string) (map[uint]string, error) {
 		if !file.IsDir() && strings.HasSuffix(name, ".md") {
 			id++
 			results[id] = filepath.Join(src, dir, name)
+			continue
+		}
+
+		index := filepath.Join(src, dir, name, bundleEntry)
+		if _, err := os.Stat(index); file.IsDir() && err == nil {
+			id++
+			results[id] = index
 		}
 	}
 
 	return results, nil
 }
 
+// IsBundle reports whether the entry is the markdown file of a page bundle.
+func IsBundle(entryfile string) bool {
+	return filepath.Base(entryfile) == bundleEntry
+}
+
+// EntryName returns the filename of an entry without extension,
+// or the name of the directory for page bundles.
+func EntryName(entryfile string) string {
+	if IsBundle(entryfile) {
+		return filepath.Base(filepath.Dir(entryfile))
+	}
+	return strings.TrimSuffix(filepath.Base(entryfile), ".md")
+}
+
 // ListDrafts returns a list of entries in `draft/` and assigns an ID.
 func ListDrafts() (map[uint]string, error) {
 	return list("draft")
@@ -77,36 +113,300 @@ func move(src, dst string) error {
 	return os.Rename(src, dst)
 }
 
-// Publish moves an entry from `draft/` to `published/`
+// Publish moves an entry from `draft/` to `published/`, with its directory for page bundles
 func Publish(from string) error {
-	parts := strings.Split(from, "/")
-	filename := parts[len(parts)-1]
-	to := filepath.Join(src, "published", filename)
+	if IsBundle(from) {
+		from = filepath.Dir(from)
+	}
+	to := filepath.Join(src, "published", filepath.Base(from))
 	return move(from, to)
 }
 
+// CopyBundleAssets replaces the contents of `blog/<slug>/` with the files
+// of the page bundle, other than markdown
+func CopyBundleAssets(entryfile string, slug string) error {
+	bundle := filepath.Dir(entryfile)
+	to := filepath.Join(dst, slug)
+
+	err := os.RemoveAll(to)
+	if err != nil {
+		return err
+	}
+
+	return filepath.WalkDir(bundle, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasSuffix(d.Name(), ".md") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(bundle, path)
+		if err != nil {
+			return err
+		}
+		return copyFile(path, filepath.Join(to, rel))
+	})
+}
+
+func copyFile(from, to string) error {
+	err := os.MkdirAll(filepath.Dir(to), 0755)
+	if err != nil {
+		return err
+	}
+
+	in, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(to)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(out, in)
+	if err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// ListPageDirs returns the directories with the assets of page bundles in `blog/`
+func ListPageDirs() ([]string, error) {
+	dirs := []string{}
+
+	files, err := os.ReadDir(dst)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		if file.IsDir() && filepath.Join(dst, file.Name()) != ImagesDir() {
+			dirs = append(dirs, file.Name())
+		}
+	}
+
+	return dirs, nil
+}
+
+// RemovePageDir removes the assets of a page bundle from `blog/`
+func RemovePageDir(name string) error {
+	return os.RemoveAll(filepath.Join(dst, name))
+}
+
 // CreateFeed creates a `feed.xml` file
 func CreateFeed() (*os.File, error) {
 	return os.Create(filepath.Join(dst, "feed.xml"))
 }
 
+// CreateAtomFeed creates an `atom.xml` file
+func CreateAtomFeed() (*os.File, error) {
+	return os.Create(filepath.Join(dst, "atom.xml"))
+}
+
+// CreateJSONFeed creates a `feed.json` file
+func CreateJSONFeed() (*os.File, error) {
+	return os.Create(filepath.Join(dst, "feed.json"))
+}
+
+// CreateSitemap creates a `sitemap.xml` file
+func CreateSitemap() (*os.File, error) {
+	return os.Create(filepath.Join(indexDst, "sitemap.xml"))
+}
+
+// CreateRobots creates a `robots.txt` file
+func CreateRobots() (*os.File, error) {
+	return os.Create(filepath.Join(indexDst, "robots.txt"))
+}
+
 // CreateIndex creates `blog.html`
 func CreateIndex() (*os.File, error) {
 	return os.Create(filepath.Join(indexDst, "blog.html"))
 }
 
+// resetTags removes the entries of the `tags/` dir matching `remove`
+func resetTags(remove func(os.DirEntry) bool) error {
+	tags := filepath.Join(indexDst, "tags")
+	err := os.MkdirAll(tags, 0755)
+	if err != nil {
+		return err
+	}
+
+	files, err := os.ReadDir(tags)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if remove(file) {
+			err = os.RemoveAll(filepath.Join(tags, file.Name()))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ResetTagPages removes all tag html files
+func ResetTagPages() error {
+	return resetTags(func(file os.DirEntry) bool {
+		return !file.IsDir() && strings.HasSuffix(file.Name(), ".html")
+	})
+}
+
+// ResetTagFeeds removes all tag feeds
+func ResetTagFeeds() error {
+	return resetTags(func(file os.DirEntry) bool {
+		return file.IsDir()
+	})
+}
+
+// CreateTagPage creates the html file listing the entries of a tag
+func CreateTagPage(tag string) (*os.File, error) {
+	return os.Create(filepath.Join(indexDst, "tags", tag+".html"))
+}
+
+// CreateTagFeed creates a feed file, such as `feed.xml`, for a tag
+func CreateTagFeed(tag string, filename string) (*os.File, error) {
+	dir := filepath.Join(indexDst, "tags", tag)
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+	return os.Create(filepath.Join(dir, filename))
+}
+
 // CreatePages creates an html file
 func CreatePage(filename string) (*os.File, error) {
 	return os.Create(filepath.Join(dst, filename+".html"))
 }
 
+// RemovePage deletes a published html file
+func RemovePage(filename string) error {
+	return os.Remove(filepath.Join(dst, filename))
+}
+
 // CreateDraft creates a .md draft file
 func CreateDraft(filename string) (*os.File, error) {
 	return os.Create(filepath.Join(src, "draft", filename))
 }
 
-// GetPublishedEntry retruns a .md file from the `published/` dir
-func GetPublishedEntry(filename string) (*os.File, error) {
-	path := filepath.Join(src, "published", strings.TrimSuffix(filename, "html")+"md")
-	return os.Open(path)
+// StatAsset returns the file info of `name` in `assets/`
+func StatAsset(name string) (os.FileInfo, error) {
+	return os.Stat(filepath.Join(indexDst, "assets", filepath.FromSlash(name)))
+}
+
+// StatBundleFile returns the file info of `name` in the page bundle of `entryfile`
+func StatBundleFile(entryfile string, name string) (os.FileInfo, error) {
+	return os.Stat(filepath.Join(filepath.Dir(entryfile), filepath.FromSlash(name)))
+}
+
+// LocalPath returns the file in `docs/` served at the URL path `ref`,
+// relative paths are resolved against the blog.
+func LocalPath(ref string) string {
+	if strings.HasPrefix(ref, "/") {
+		return filepath.Join(indexDst, filepath.FromSlash(ref))
+	}
+	return filepath.Join(dst, filepath.FromSlash(ref))
+}
+
+// ImagesDir returns the directory of the resized images, `blog/img/`
+func ImagesDir() string {
+	return filepath.Join(dst, "img")
+}
+
+// ListImages returns the names of the resized images in `blog/img/`
+func ListImages() ([]string, error) {
+	names := []string{}
+
+	files, err := os.ReadDir(ImagesDir())
+	if os.IsNotExist(err) {
+		return names, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		if !file.IsDir() {
+			names = append(names, file.Name())
+		}
+	}
+
+	return names, nil
+}
+
+// RemoveImage deletes a resized image from `blog/img/`
+func RemoveImage(name string) error {
+	return os.Remove(filepath.Join(ImagesDir(), name))
+}
+
+// ListLogos returns the names, without extension, of the tag logos in `assets/logos/`
+func ListLogos() (map[string]bool, error) {
+	logos := make(map[string]bool)
+
+	files, err := os.ReadDir(filepath.Join(indexDst, "assets", "logos"))
+	if os.IsNotExist(err) {
+		return logos, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		name := file.Name()
+		if !file.IsDir() && strings.HasSuffix(name, ".png") {
+			logos[strings.TrimSuffix(name, ".png")] = true
+		}
+	}
+
+	return logos, nil
+}
+
+// ListSitePages returns the HTML files in `docs/`, as URL paths from the site root
+func ListSitePages() ([]string, error) {
+	pages := []string{}
+
+	err := filepath.WalkDir(indexDst, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".html") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(indexDst, path)
+		if err != nil {
+			return err
+		}
+		pages = append(pages, "/"+filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pages, nil
+}
+
+// WriteTemplate renders the text template `name` from `templates/<file>` into the file returned by `create`,
+// for the generated files that are not HTML, like feeds and the sitemap.
+func WriteTemplate(data any, file string, name string, create func() (*os.File, error)) error {
+	tmpl, err := template.ParseFiles(filepath.Join("templates", file))
+	if err != nil {
+		return err
+	}
+
+	f, err := create()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return tmpl.ExecuteTemplate(f, name, data)
 }
@@ -0,0 +1,275 @@
+// Package highlight colorizes source code at build time,
+// wrapping tokens in `<span class="hl-*">` elements styled by main.css.
+package highlight
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// Token classes, used as the suffix of the `hl-` CSS classes.
+const (
+	Comment  = "comment"
+	Keyword  = "keyword"
+	Builtin  = "builtin"
+	Literal  = "literal"
+	Function = "function"
+	String   = "string"
+	Number   = "number"
+	Variable = "variable"
+	Key      = "key"
+	Target   = "target"
+)
+
+// Code returns `code` as escaped HTML with its tokens wrapped in spans.
+// Unsupported languages are escaped without highlighting.
+func Code(lang string, code string) string {
+	l, ok := languages[strings.ToLower(lang)]
+	if !ok {
+		return html.EscapeString(code)
+	}
+
+	var b strings.Builder
+	l.tokenize([]rune(code), func(class string, text []rune) {
+		escaped := html.EscapeString(string(text))
+		if class == "" {
+			b.WriteString(escaped)
+			return
+		}
+		b.WriteString(`<span class="hl-` + class + `">` + escaped + `</span>`)
+	})
+	return b.String()
+}
+
+// tokenize splits `src` into tokens, calling `emit` for each of them
+// with its class, or an empty class for plain text.
+func (l *language) tokenize(src []rune, emit func(class string, text []rune)) {
+	n := len(src)
+	plain := 0
+	flush := func(i int) {
+		if plain < i {
+			emit("", src[plain:i])
+		}
+	}
+	token := func(i, j int, class string) int {
+		flush(i)
+		emit(class, src[i:j])
+		plain = j
+		return j
+	}
+
+	lineStart := true
+	for i := 0; i < n; {
+		r := src[i]
+		atLineStart := lineStart
+		lineStart = r == '\n'
+
+		if atLineStart && l.targets {
+			j := i
+			for j < n && !unicode.IsSpace(src[j]) && !strings.ContainsRune(":=#$", src[j]) {
+				j++
+			}
+			if j > i && j < n && src[j] == ':' && (j+1 == n || src[j+1] != '=') {
+				i = token(i, j, Target)
+				continue
+			}
+		}
+
+		if l.variables && r == '$' && i+1 < n {
+			if j := variableEnd(src, i); j > i+1 {
+				i = token(i, j, Variable)
+				continue
+			}
+		}
+
+		if j, ok := l.commentEnd(src, i); ok {
+			i = token(i, j, Comment)
+			continue
+		}
+
+		if strings.ContainsRune(l.quotes, r) {
+			j := l.stringEnd(src, i)
+			class := String
+			if l.keys && nextRune(src, j) == ':' {
+				class = Key
+			}
+			i = token(i, j, class)
+			continue
+		}
+
+		if l.charLiterals && r == '\'' {
+			if j := charEnd(src, i); j > i {
+				i = token(i, j, String)
+				continue
+			}
+		}
+
+		if unicode.IsDigit(r) {
+			j := i + 1
+			for j < n && (isIdent(src[j]) || src[j] == '.' && (j+1 == n || src[j+1] != '.')) {
+				j++
+			}
+			i = token(i, j, Number)
+			continue
+		}
+
+		if unicode.IsLetter(r) || r == '_' {
+			j := i + 1
+			for j < n && (isIdent(src[j]) || strings.ContainsRune(l.identChars, src[j])) {
+				j++
+			}
+			afterDot := i > 0 && src[i-1] == '.'
+			if class := l.classify(string(src[i:j]), afterDot, nextRune(src, j)); class != "" {
+				token(i, j, class)
+			}
+			i = j
+			continue
+		}
+
+		i++
+	}
+	flush(n)
+}
+
+// classify returns the class of a word, `next` is the first non-blank rune after it.
+// Words after a "." are fields or methods, so they are never keywords.
+func (l *language) classify(word string, afterDot bool, next rune) string {
+	key := word
+	if l.ignoreCase {
+		key = strings.ToLower(word)
+	}
+
+	switch {
+	case !afterDot && l.keywords[key]:
+		return Keyword
+	case !afterDot && l.literals[key]:
+		return Literal
+	case !afterDot && l.builtins[key]:
+		return Builtin
+	case next == '(':
+		return Function
+	default:
+		return ""
+	}
+}
+
+// commentEnd returns the end of the comment starting at `i`, if any.
+// A "#" only starts a comment at the beginning of a word, as in `$#` or `a#b` it does not.
+func (l *language) commentEnd(src []rune, i int) (int, bool) {
+	for _, prefix := range l.lineComments {
+		if !hasPrefix(src[i:], prefix) {
+			continue
+		}
+		if prefix == "#" && i > 0 && !unicode.IsSpace(src[i-1]) {
+			continue
+		}
+		j := i
+		for j < len(src) && src[j] != '\n' {
+			j++
+		}
+		return j, true
+	}
+
+	open, closing := l.blockComment[0], l.blockComment[1]
+	if open != "" && hasPrefix(src[i:], open) {
+		j := i + len(open)
+		for j < len(src) && !hasPrefix(src[j:], closing) {
+			j++
+		}
+		return min(j+len(closing), len(src)), true
+	}
+
+	return 0, false
+}
+
+// stringEnd returns the end of the string starting at `i`, including the closing quote.
+// Unterminated strings end with the line, or the code for multiline ones.
+func (l *language) stringEnd(src []rune, i int) int {
+	quote := src[i]
+	raw := strings.ContainsRune(l.rawQuotes, quote)
+	multiline := strings.ContainsRune(l.multilineQuotes, quote)
+
+	for j := i + 1; j < len(src); j++ {
+		switch {
+		case src[j] == '\\' && !raw:
+			j++
+		case src[j] == quote:
+			return j + 1
+		case src[j] == '\n' && !multiline:
+			return j
+		}
+	}
+	return len(src)
+}
+
+// charEnd returns the end of a character literal like 'a' or '\n' starting at `i`,
+// or `i` when the quote is something else, like a Rust lifetime.
+func charEnd(src []rune, i int) int {
+	if i+2 < len(src) && src[i+1] != '\\' && src[i+2] == '\'' {
+		return i + 3
+	}
+	if i+1 < len(src) && src[i+1] == '\\' {
+		for j := i + 2; j < len(src) && j < i+12 && src[j] != '\n'; j++ {
+			if src[j] == '\'' {
+				return j + 1
+			}
+		}
+	}
+	return i
+}
+
+// variableEnd returns the end of the variable starting with the "$" at `i`:
+// `$name`, `${name}`, `$(name)` or special ones like `$@` and `$1`.
+func variableEnd(src []rune, i int) int {
+	next := src[i+1]
+
+	switch {
+	case next == '{' || next == '(':
+		open, closing := next, '}'
+		if open == '(' {
+			closing = ')'
+		}
+		depth := 0
+		for j := i + 1; j < len(src) && src[j] != '\n'; j++ {
+			switch src[j] {
+			case open:
+				depth++
+			case closing:
+				depth--
+				if depth == 0 {
+					return j + 1
+				}
+			}
+		}
+		return i
+	case unicode.IsLetter(next) || next == '_':
+		j := i + 1
+		for j < len(src) && isIdent(src[j]) {
+			j++
+		}
+		return j
+	case strings.ContainsRune("@<^?*#!$-0123456789", next):
+		return i + 2
+	default:
+		return i
+	}
+}
+
+func isIdent(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+func hasPrefix(src []rune, prefix string) bool {
+	return strings.HasPrefix(string(src[:min(len(src), len(prefix))]), prefix)
+}
+
+// nextRune returns the first rune from `i` that is not a space or tab.
+func nextRune(src []rune, i int) rune {
+	for ; i < len(src); i++ {
+		if src[i] != ' ' && src[i] != '\t' {
+			return src[i]
+		}
+	}
+	return 0
+}
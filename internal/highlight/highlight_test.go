@@ -0,0 +1,84 @@
+package highlight
+
+import (
+	"testing"
+)
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		lang string
+		code string
+		want string
+	}{
+		{
+			name: "go",
+			lang: "go",
+			code: "func main() {\n\tfmt.Println(\"hi\", 42) // greet\n}",
+			want: `<span class="hl-keyword">func</span> <span class="hl-function">main</span>() {` + "\n\t" +
+				`fmt.<span class="hl-function">Println</span>(<span class="hl-string">&#34;hi&#34;</span>, <span class="hl-number">42</span>) <span class="hl-comment">// greet</span>` + "\n}",
+		},
+		{
+			name: "go raw string and builtins",
+			lang: "golang",
+			code: "var s string = `a\\n`",
+			want: `<span class="hl-keyword">var</span> s <span class="hl-builtin">string</span> = <span class="hl-string">` + "`a\\n`" + `</span>`,
+		},
+		{
+			name: "typescript",
+			lang: "ts",
+			code: "const ok: boolean = x.delete(null); /* done */",
+			want: `<span class="hl-keyword">const</span> ok: <span class="hl-builtin">boolean</span> = x.<span class="hl-function">delete</span>(<span class="hl-literal">null</span>); <span class="hl-comment">/* done */</span>`,
+		},
+		{
+			name: "rust char and lifetime",
+			lang: "rust",
+			code: "fn f<'a>(c: char) -> bool { c == 'x' }",
+			want: `<span class="hl-keyword">fn</span> f&lt;&#39;a&gt;(c: <span class="hl-builtin">char</span>) -&gt; <span class="hl-builtin">bool</span> { c == <span class="hl-string">&#39;x&#39;</span> }`,
+		},
+		{
+			name: "sql ignores case",
+			lang: "sql",
+			code: "SELECT id FROM users -- all",
+			want: `<span class="hl-keyword">SELECT</span> id <span class="hl-keyword">FROM</span> users <span class="hl-comment">-- all</span>`,
+		},
+		{
+			name: "shell",
+			lang: "sh",
+			code: "echo \"$HOME\" $# # comment",
+			want: `<span class="hl-builtin">echo</span> <span class="hl-string">&#34;$HOME&#34;</span> <span class="hl-variable">$#</span> <span class="hl-comment"># comment</span>`,
+		},
+		{
+			name: "makefile",
+			lang: "makefile",
+			code: "BIN := gdv\nbuild: test\n\tgo build -o $(BIN)",
+			want: "BIN := gdv\n" + `<span class="hl-target">build</span>: test` + "\n\tgo build -o " + `<span class="hl-variable">$(BIN)</span>`,
+		},
+		{
+			name: "json",
+			lang: "json",
+			code: `{"a": "b", "c": true}`,
+			want: `{<span class="hl-key">&#34;a&#34;</span>: <span class="hl-string">&#34;b&#34;</span>, <span class="hl-key">&#34;c&#34;</span>: <span class="hl-literal">true</span>}`,
+		},
+		{
+			name: "unknown language is escaped",
+			lang: "cobol",
+			code: "<b>",
+			want: "&lt;b&gt;",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Code(tt.lang, tt.code)
+			if got != tt.want {
+				t.Errorf("want\n%s\ngot\n%s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package highlight
+
+// language describes the tokens of a programming language well enough
+// to colorize it, it is not meant to validate the code.
+type language struct {
+	keywords map[string]bool
+	builtins map[string]bool
+	literals map[string]bool
+	// lineComments start a comment that runs until the end of the line.
+	lineComments []string
+	// blockComment holds the opening and closing delimiters of a multi-line comment.
+	blockComment [2]string
+	// quotes start a string that ends with the same character.
+	quotes string
+	// rawQuotes are the quotes where "\" does not escape.
+	rawQuotes string
+	// multilineQuotes are the quotes whose strings may span several lines.
+	multilineQuotes string
+	// identChars are allowed in identifiers besides letters, digits and "_".
+	identChars string
+	// variables are prefixed with "$", like in shell scripts.
+	variables bool
+	// targets are words at the start of a line followed by ":", like in Makefiles.
+	targets bool
+	// keys are strings followed by ":", like in JSON objects.
+	keys bool
+	// charLiterals are single characters between "'", any other "'" is left alone.
+	charLiterals bool
+	// ignoreCase matches keywords regardless of their case.
+	ignoreCase bool
+}
+
+func set(words ...string) map[string]bool {
+	m := make(map[string]bool, len(words))
+	for _, w := range words {
+		m[w] = true
+	}
+	return m
+}
+
+var golang = &language{
+	keywords: set(
+		"break", "case", "chan", "const", "continue", "default", "defer", "else",
+		"fallthrough", "for", "func", "go", "goto", "if", "import", "interface",
+		"map", "package", "range", "return", "select", "struct", "switch", "type", "var",
+	),
+	builtins: set(
+		"any", "bool", "byte", "comparable", "complex64", "complex128", "error",
+		"float32", "float64", "int", "int8", "int16", "int32", "int64", "rune",
+		"string", "uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+		"append", "cap", "clear", "close", "complex", "copy", "delete", "imag",
+		"len", "make", "max", "min", "new", "panic", "print", "println", "real", "recover",
+	),
+	literals:        set("true", "false", "nil", "iota"),
+	lineComments:    []string{"//"},
+	blockComment:    [2]string{"/*", "*/"},
+	quotes:          `"'` + "`",
+	rawQuotes:       "`",
+	multilineQuotes: "`",
+}
+
+var typescript = &language{
+	keywords: set(
+		"abstract", "as", "async", "await", "break", "case", "catch", "class", "const",
+		"constructor", "continue", "debugger", "declare", "default", "delete", "do",
+		"else", "enum", "export", "extends", "finally", "for", "from", "function",
+		"get", "if", "implements", "import", "in", "instanceof", "interface", "is",
+		"keyof", "let", "namespace", "new", "of", "private", "protected", "public",
+		"readonly", "return", "satisfies", "set", "static", "super", "switch", "this",
+		"throw", "try", "type", "typeof", "var", "void", "while", "with", "yield",
+	),
+	builtins: set(
+		"any", "bigint", "boolean", "never", "number", "object", "string", "symbol",
+		"unknown", "Array", "Date", "Error", "JSON", "Map", "Math", "Object",
+		"Promise", "Record", "Partial", "Readonly", "Set", "console", "require",
+	),
+	literals:        set("true", "false", "null", "undefined", "NaN", "Infinity"),
+	lineComments:    []string{"//"},
+	blockComment:    [2]string{"/*", "*/"},
+	quotes:          `"'` + "`",
+	multilineQuotes: "`",
+	identChars:      "$",
+}
+
+var rust = &language{
+	keywords: set(
+		"as", "async", "await", "break", "const", "continue", "crate", "dyn", "else",
+		"enum", "extern", "fn", "for", "if", "impl", "in", "let", "loop", "match",
+		"mod", "move", "mut", "pub", "ref", "return", "self", "Self", "static",
+		"struct", "super", "trait", "type", "unsafe", "use", "where", "while",
+	),
+	builtins: set(
+		"bool", "char", "f32", "f64", "i8", "i16", "i32", "i64", "i128", "isize",
+		"str", "u8", "u16", "u32", "u64", "u128", "usize", "Box", "Option",
+		"Result", "String", "Vec", "Some", "None", "Ok", "Err",
+	),
+	literals:     set("true", "false"),
+	lineComments: []string{"//"},
+	blockComment: [2]string{"/*", "*/"},
+	quotes:       `"`,
+	charLiterals: true,
+}
+
+var sql = &language{
+	keywords: set(
+		"add", "all", "alter", "and", "as", "asc", "begin", "between", "by", "cascade",
+		"case", "check", "commit", "constraint", "create", "default", "delete", "desc",
+		"distinct", "drop", "else", "end", "exists", "foreign", "from", "full", "group",
+		"having", "if", "in", "index", "inner", "insert", "into", "is", "join", "key",
+		"left", "like", "limit", "not", "offset", "on", "or", "order", "outer",
+		"primary", "references", "returning", "right", "rollback", "select", "set",
+		"table", "then", "transaction", "union", "unique", "update", "using", "values",
+		"when", "where", "with",
+	),
+	builtins: set(
+		"bigint", "bigserial", "boolean", "bytea", "char", "date", "decimal", "integer",
+		"int", "json", "jsonb", "numeric", "serial", "smallint", "text", "time",
+		"timestamp", "timestamptz", "uuid", "varchar", "count", "sum", "avg", "min",
+		"max", "now", "coalesce",
+	),
+	literals:     set("true", "false", "null"),
+	lineComments: []string{"--"},
+	blockComment: [2]string{"/*", "*/"},
+	quotes:       `'"`,
+	ignoreCase:   true,
+}
+
+var shell = &language{
+	keywords: set(
+		"case", "do", "done", "elif", "else", "esac", "export", "fi", "for",
+		"function", "if", "in", "local", "readonly", "return", "select", "then",
+		"until", "while",
+	),
+	builtins: set(
+		"alias", "cd", "echo", "eval", "exec", "exit", "printf", "pwd", "read",
+		"set", "shift", "source", "test", "trap", "unset",
+	),
+	literals:        set("true", "false"),
+	lineComments:    []string{"#"},
+	quotes:          `"'`,
+	rawQuotes:       "'",
+	multilineQuotes: `"'`,
+	identChars:      "-",
+	variables:       true,
+}
+
+var makefile = &language{
+	keywords: set(
+		"define", "else", "endef", "endif", "export", "ifdef", "ifeq", "ifndef",
+		"ifneq", "include", "override", "unexport",
+	),
+	builtins: set(
+		"call", "dir", "eval", "filter", "foreach", "notdir", "patsubst", "shell",
+		"sort", "strip", "subst", "wildcard", "word", "words",
+	),
+	lineComments: []string{"#"},
+	quotes:       `"'`,
+	rawQuotes:    "'",
+	identChars:   "-",
+	variables:    true,
+	targets:      true,
+}
+
+var json = &language{
+	literals: set("true", "false", "null"),
+	quotes:   `"`,
+	keys:     true,
+}
+
+// languages maps the info string of fenced code blocks to a language.
+var languages = map[string]*language{
+	"go":         golang,
+	"golang":     golang,
+	"typescript": typescript,
+	"ts":         typescript,
+	"tsx":        typescript,
+	"javascript": typescript,
+	"js":         typescript,
+	"jsx":        typescript,
+	"rust":       rust,
+	"rs":         rust,
+	"sql":        sql,
+	"postgres":   sql,
+	"postgresql": sql,
+	"sh":         shell,
+	"shell":      shell,
+	"bash":       shell,
+	"zsh":        shell,
+	"console":    shell,
+	"makefile":   makefile,
+	"make":       makefile,
+	"mk":         makefile,
+	"json":       json,
+}
@@ -0,0 +1,141 @@
+// Package images generates resized variants of the images in entries,
+// so pages can serve them responsively.
+package images
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/image/draw"
+)
+
+// Widths are the widths, in pixels, of the generated variants.
+// Only those narrower than the original image are generated.
+var Widths = []int{480, 960, 1440}
+
+// Variant is a resized copy of an image.
+type Variant struct {
+	// Name is the filename of the variant in the output directory.
+	Name  string
+	URL   string
+	Width int
+}
+
+// Image is the result of processing an image.
+type Image struct {
+	// Width and Height are the intrinsic size of the original image.
+	Width    int
+	Height   int
+	Variants []Variant
+}
+
+// Supported reports whether the file is an image that can be resized.
+func Supported(file string) bool {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".png", ".jpg", ".jpeg":
+		return true
+	default:
+		return false
+	}
+}
+
+// Process generates the variants of the image `src` in `outDir`, served at `urlPrefix`.
+// Variants are named after the hash of the original content and reused when they exist,
+// so only new or modified images are decoded and resized.
+func Process(src string, outDir string, urlPrefix string) (*Image, error) {
+	content, err := os.ReadFile(src)
+	if err != nil {
+		return nil, err
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", src, err)
+	}
+
+	sum := sha256.Sum256(content)
+	hash := hex.EncodeToString(sum[:])[:12]
+	ext := filepath.Ext(src)
+	base := strings.TrimSuffix(filepath.Base(src), ext)
+
+	img := &Image{Width: config.Width, Height: config.Height, Variants: []Variant{}}
+	var decoded image.Image
+
+	for _, width := range Widths {
+		if width >= config.Width {
+			break
+		}
+
+		name := fmt.Sprintf("%s-%s-%d%s", base, hash, width, ext)
+		img.Variants = append(img.Variants, Variant{Name: name, URL: urlPrefix + name, Width: width})
+
+		dst := filepath.Join(outDir, name)
+		if _, err := os.Stat(dst); err == nil {
+			continue
+		}
+
+		if decoded == nil {
+			decoded, _, err = image.Decode(bytes.NewReader(content))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", src, err)
+			}
+		}
+
+		height := config.Height * width / config.Width
+		err = write(dst, resize(decoded, width, height), format)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return img, nil
+}
+
+func resize(src image.Image, width, height int) image.Image {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Over, nil)
+	return dst
+}
+
+// write encodes the image in `format` to a temporary file, renamed to `dst` once complete.
+func write(dst string, img image.Image, format string) error {
+	err := os.MkdirAll(filepath.Dir(dst), 0755)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(dst), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	switch format {
+	case "png":
+		err = png.Encode(f, img)
+	case "jpeg":
+		err = jpeg.Encode(f, img, &jpeg.Options{Quality: 85})
+	default:
+		err = errors.New("unsupported image format " + format)
+	}
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), dst)
+}
@@ -0,0 +1,106 @@
+package images
+
+import (
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writePNG(t *testing.T, file string, width, height int) {
+	t.Helper()
+
+	f, err := os.Create(file)
+	if err != nil {
+		t.Fatalf("Error creating image: %s", err)
+	}
+	defer f.Close()
+
+	err = png.Encode(f, image.NewRGBA(image.Rect(0, 0, width, height)))
+	if err != nil {
+		t.Fatalf("Error encoding image: %s", err)
+	}
+}
+
+func TestProcessGeneratesVariants(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "photo.png")
+	out := filepath.Join(dir, "img")
+	writePNG(t, src, 1000, 500)
+
+	img, err := Process(src, out, "/blog/img/")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if img.Width != 1000 || img.Height != 500 {
+		t.Errorf("want size 1000x500, got %dx%d", img.Width, img.Height)
+	}
+	if len(img.Variants) != 2 {
+		t.Fatalf("want variants narrower than the original, got %+v", img.Variants)
+	}
+
+	for i, width := range []int{480, 960} {
+		v := img.Variants[i]
+		if v.Width != width {
+			t.Errorf("want width %d, got %d", width, v.Width)
+		}
+
+		f, err := os.Open(filepath.Join(out, filepath.Base(v.URL)))
+		if err != nil {
+			t.Fatalf("Expected variant %s: %s", v.URL, err)
+		}
+		config, err := png.DecodeConfig(f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("Error decoding variant: %s", err)
+		}
+		if config.Width != width || config.Height != width/2 {
+			t.Errorf("want variant %dx%d, got %dx%d", width, width/2, config.Width, config.Height)
+		}
+	}
+}
+
+func TestProcessReusesVariants(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "photo.png")
+	out := filepath.Join(dir, "img")
+	writePNG(t, src, 600, 600)
+
+	img, err := Process(src, out, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	variant := filepath.Join(out, img.Variants[0].URL)
+	err = os.WriteFile(variant, []byte("cached"), 0644)
+	if err != nil {
+		t.Fatalf("Error writing variant: %s", err)
+	}
+
+	_, err = Process(src, out, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	content, err := os.ReadFile(variant)
+	if err != nil {
+		t.Fatalf("Error reading variant: %s", err)
+	}
+	if string(content) != "cached" {
+		t.Error("Expected existing variant to be reused")
+	}
+
+	// A different image gets new variants.
+	writePNG(t, src, 600, 300)
+	changed, err := Process(src, out, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if changed.Variants[0].URL == img.Variants[0].URL {
+		t.Error("Expected new variants for a modified image")
+	}
+}
@@ -0,0 +1,294 @@
+package links
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"germandv.xyz/internal/editor"
+	"germandv.xyz/internal/filer"
+)
+
+// ExternalOptions configure the check of external links.
+type ExternalOptions struct {
+	// CacheFile is the JSON file keeping the results between runs.
+	CacheFile string
+	// MaxAge is how long a cached result that is not broken is trusted before checking the link again.
+	MaxAge time.Duration
+	// Concurrency is the maximum number of requests in flight.
+	Concurrency int
+	// HostInterval is the minimum time between two requests to the same host.
+	HostInterval time.Duration
+	// Offline only reports the cached results, without making any request.
+	Offline bool
+	// Client makes the requests, redirects are never followed.
+	Client *http.Client
+}
+
+// Status is the outcome of checking an external URL.
+type Status struct {
+	Code int `json:"code,omitempty"`
+	// Location is where redirected links point to.
+	Location  string    `json:"location,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// Checked reports whether the URL was checked, it is not in offline mode without a cached result.
+func (s Status) Checked() bool {
+	return !s.CheckedAt.IsZero()
+}
+
+// Broken reports whether the request failed or got a 4xx/5xx response.
+func (s Status) Broken() bool {
+	return s.Error != "" || s.Code >= 400
+}
+
+// Redirected reports whether the response was a redirect.
+func (s Status) Redirected() bool {
+	return s.Code >= 300 && s.Code < 400
+}
+
+// ExternalLink is an external URL referenced by a published entry.
+type ExternalLink struct {
+	File   string
+	URL    string
+	Status Status
+}
+
+func (l ExternalLink) String() string {
+	switch {
+	case !l.Status.Checked():
+		return fmt.Sprintf("%s: not checked %s", l.File, l.URL)
+	case l.Status.Error != "":
+		return fmt.Sprintf("%s: %s: %s", l.File, l.URL, l.Status.Error)
+	case l.Status.Redirected():
+		return fmt.Sprintf("%s: %d %s -> %s", l.File, l.Status.Code, l.URL, l.Status.Location)
+	default:
+		return fmt.Sprintf("%s: %d %s", l.File, l.Status.Code, l.URL)
+	}
+}
+
+// CheckExternal checks the external URLs of all published entries, reusing the
+// results cached in `opts.CacheFile` that are newer than `opts.MaxAge`.
+// Broken links are always checked again, unless offline.
+// It returns every link, sorted by entry and URL.
+func CheckExternal(opts ExternalOptions) ([]ExternalLink, error) {
+	links, err := collectExternal()
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := loadCache(opts.CacheFile)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := []string{}
+	seen := make(map[string]bool)
+	for _, link := range links {
+		if !seen[link.URL] {
+			seen[link.URL] = true
+			urls = append(urls, link.URL)
+		}
+	}
+
+	results := checkURLs(urls, cache, opts)
+	for i := range links {
+		links[i].Status = results[links[i].URL]
+	}
+
+	if opts.Offline {
+		return links, nil
+	}
+	return links, saveCache(opts.CacheFile, results)
+}
+
+// collectExternal returns the http(s) links of the published entries,
+// other than those to the site itself.
+func collectExternal() ([]ExternalLink, error) {
+	files, err := filer.ListPublished()
+	if err != nil {
+		return nil, err
+	}
+
+	links := []ExternalLink{}
+	for _, file := range files {
+		_, body, err := editor.ParseMd(file)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := editor.ToHTML(body, file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+
+		seen := make(map[string]bool)
+		for _, m := range linkAttr.FindAllStringSubmatch(string(content), -1) {
+			ref, err := url.Parse(html.UnescapeString(m[1]))
+			if err != nil || (ref.Scheme != "http" && ref.Scheme != "https") {
+				continue
+			}
+			u := ref.String()
+			if seen[u] || u == site.BaseURL || strings.HasPrefix(u, site.BaseURL+"/") {
+				continue
+			}
+			seen[u] = true
+			links = append(links, ExternalLink{File: file, URL: u})
+		}
+	}
+
+	sort.Slice(links, func(i, j int) bool {
+		if links[i].File != links[j].File {
+			return links[i].File < links[j].File
+		}
+		return links[i].URL < links[j].URL
+	})
+
+	return links, nil
+}
+
+// checkURLs returns the status of each URL, from the cache when offline or when
+// it is not broken and fresh enough, otherwise requesting it.
+func checkURLs(urls []string, cache map[string]Status, opts ExternalOptions) map[string]Status {
+	results := make(map[string]Status)
+	todo := []string{}
+
+	for _, u := range urls {
+		status, ok := cache[u]
+		fresh := !status.Broken() && time.Since(status.CheckedAt) < opts.MaxAge
+		if ok && (opts.Offline || fresh) {
+			results[u] = status
+			continue
+		}
+		if !opts.Offline {
+			todo = append(todo, u)
+		}
+	}
+
+	client := &http.Client{Timeout: 15 * time.Second}
+	if opts.Client != nil {
+		copied := *opts.Client
+		client = &copied
+	}
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	limiter := &hostLimiter{interval: opts.HostInterval, next: make(map[string]time.Time)}
+	slots := make(chan struct{}, max(opts.Concurrency, 1))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, u := range todo {
+		wg.Add(1)
+		go func(u string) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			status := checkURL(client, limiter, u)
+
+			mu.Lock()
+			results[u] = status
+			mu.Unlock()
+		}(u)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// checkURL requests `u` with HEAD, falling back to GET for servers that do not support it.
+func checkURL(client *http.Client, limiter *hostLimiter, u string) Status {
+	host := ""
+	if ref, err := url.Parse(u); err == nil {
+		host = ref.Host
+	}
+
+	limiter.wait(host)
+	status := request(client, http.MethodHead, u)
+	if status.Broken() {
+		limiter.wait(host)
+		status = request(client, http.MethodGet, u)
+	}
+
+	status.CheckedAt = time.Now().UTC()
+	return status
+}
+
+func request(client *http.Client, method string, u string) Status {
+	req, err := http.NewRequest(method, u, nil)
+	if err != nil {
+		return Status{Error: err.Error()}
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; gdv link checker)")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Status{Error: err.Error()}
+	}
+	defer resp.Body.Close()
+
+	status := Status{Code: resp.StatusCode}
+	if location, err := resp.Location(); err == nil {
+		status.Location = location.String()
+	}
+	return status
+}
+
+// hostLimiter spaces the requests to each host by `interval`.
+type hostLimiter struct {
+	interval time.Duration
+	mu       sync.Mutex
+	next     map[string]time.Time
+}
+
+// wait blocks until a request to `host` is allowed.
+func (l *hostLimiter) wait(host string) {
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next[host]
+	if at.Before(now) {
+		at = now
+	}
+	l.next[host] = at.Add(l.interval)
+	l.mu.Unlock()
+
+	time.Sleep(time.Until(at))
+}
+
+func loadCache(file string) (map[string]Status, error) {
+	cache := make(map[string]Status)
+
+	content, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return cache, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(content, &cache)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return cache, nil
+}
+
+// saveCache replaces the cache with `results`, dropping links no longer referenced.
+func saveCache(file string, results map[string]Status) error {
+	content, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, append(content, '\n'), 0644)
+}
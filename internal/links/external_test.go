@@ -0,0 +1,160 @@
+package links
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCheckURLs(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/ok":
+		case "/moved":
+			http.Redirect(w, r, "/ok", http.StatusMovedPermanently)
+		case "/no-head":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		case "/error":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	opts := ExternalOptions{MaxAge: time.Hour, Concurrency: 2, Client: srv.Client()}
+	urls := []string{srv.URL + "/ok", srv.URL + "/moved", srv.URL + "/no-head", srv.URL + "/error", srv.URL + "/gone"}
+	results := checkURLs(urls, map[string]Status{}, opts)
+
+	tests := []struct {
+		path       string
+		code       int
+		broken     bool
+		redirected bool
+	}{
+		{"/ok", 200, false, false},
+		{"/moved", 301, false, true},
+		{"/no-head", 200, false, false},
+		{"/error", 500, true, false},
+		{"/gone", 404, true, false},
+	}
+	for _, tt := range tests {
+		got := results[srv.URL+tt.path]
+		if got.Code != tt.code || got.Broken() != tt.broken || got.Redirected() != tt.redirected || !got.Checked() {
+			t.Errorf("%s: want %d (broken %v, redirected %v), got %+v", tt.path, tt.code, tt.broken, tt.redirected, got)
+		}
+	}
+	if results[srv.URL+"/moved"].Location != srv.URL+"/ok" {
+		t.Errorf("want redirect location %s, got %q", srv.URL+"/ok", results[srv.URL+"/moved"].Location)
+	}
+
+	// Fresh successes are reused, old ones and broken links are checked again.
+	cache := map[string]Status{
+		srv.URL + "/gone":  {Code: 200, CheckedAt: time.Now()},
+		srv.URL + "/moved": {Code: 200, CheckedAt: time.Now().Add(-2 * time.Hour)},
+		srv.URL + "/ok":    {Code: 404, CheckedAt: time.Now()},
+	}
+	requests.Store(0)
+	results = checkURLs([]string{srv.URL + "/gone", srv.URL + "/moved", srv.URL + "/ok"}, cache, opts)
+	if results[srv.URL+"/gone"].Code != 200 || results[srv.URL+"/moved"].Code != 301 || results[srv.URL+"/ok"].Code != 200 {
+		t.Errorf("want cached /gone and checked /moved and /ok, got %+v", results)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("want 2 requests, got %d", n)
+	}
+
+	// Offline only reports cached results.
+	requests.Store(0)
+	opts.Offline = true
+	results = checkURLs([]string{srv.URL + "/ok", srv.URL + "/no-head"}, cache, opts)
+	if results[srv.URL+"/ok"].Code != 404 || results[srv.URL+"/no-head"].Checked() {
+		t.Errorf("want only the cached result, got %+v", results)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("want no requests offline, got %d", n)
+	}
+}
+
+func TestCheckURLsLimitsRequestsPerHost(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	opts := ExternalOptions{Concurrency: 3, HostInterval: 50 * time.Millisecond, Client: srv.Client()}
+	start := time.Now()
+	checkURLs([]string{srv.URL + "/a", srv.URL + "/b", srv.URL + "/c"}, map[string]Status{}, opts)
+
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("want requests to the same host spaced by 50ms, took %s", elapsed)
+	}
+}
+
+func TestCheckExternal(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ok" {
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	published := "testdata/entries/published/with-links.md"
+	content := "---\ntitle: with-links\npublished: 2024-03-01\nrevision: 2024-03-01\nexcerpt: Links.\n---\n\n" +
+		"[ok](" + srv.URL + "/ok), [gone](" + srv.URL + "/gone), [again](" + srv.URL + "/ok), " +
+		"[home](https://germandv.me/blog.html) and [relative](/blog.html).\n"
+	err := os.WriteFile(published, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Error writing entry: %s", err)
+	}
+	t.Cleanup(func() { os.Remove(published) })
+
+	cacheFile := filepath.Join(t.TempDir(), "links.json")
+	opts := ExternalOptions{CacheFile: cacheFile, Concurrency: 1, MaxAge: time.Hour, Client: srv.Client()}
+	links, err := CheckExternal(opts)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	want := []ExternalLink{
+		{File: published, URL: srv.URL + "/gone", Status: Status{Code: 404}},
+		{File: published, URL: srv.URL + "/ok", Status: Status{Code: 200}},
+	}
+	if len(links) != len(want) {
+		t.Fatalf("want %d links, got %d: %v", len(want), len(links), links)
+	}
+	for i, w := range want {
+		if links[i].File != w.File || links[i].URL != w.URL || links[i].Status.Code != w.Status.Code {
+			t.Errorf("want %v, got %v", w, links[i])
+		}
+	}
+
+	cache, err := loadCache(cacheFile)
+	if err != nil {
+		t.Fatalf("Error loading cache: %s", err)
+	}
+	if len(cache) != 2 || cache[srv.URL+"/gone"].CheckedAt.IsZero() {
+		t.Errorf("want both results cached with a timestamp, got %+v", cache)
+	}
+
+	// Offline reports from the cache, even once the server is gone.
+	srv.Close()
+	opts.Offline = true
+	opts.MaxAge = 0
+	links, err = CheckExternal(opts)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(links) != 2 || links[0].Status.Code != 404 || links[1].Status.Code != 200 {
+		t.Errorf("want cached results, got %v", links)
+	}
+}
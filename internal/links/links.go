@@ -0,0 +1,165 @@
+// Package links checks that the links of the generated site resolve.
+package links
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"germandv.xyz/internal/config"
+	"germandv.xyz/internal/filer"
+)
+
+// Broken is a reference in a page that does not resolve.
+type Broken struct {
+	File string
+	Line int
+	Ref  string
+	Msg  string
+}
+
+func (b Broken) String() string {
+	return fmt.Sprintf("%s:%d: %s %q", b.File, b.Line, b.Msg, b.Ref)
+}
+
+// site provides the base URL, links to it are checked as internal.
+var site = config.Default()
+
+// Configure sets the site configuration used to tell internal links apart.
+func Configure(s *config.Site) {
+	site = s
+}
+
+var (
+	linkAttr = regexp.MustCompile(`\s(?:href|src)="([^"]*)"`)
+	idAttr   = regexp.MustCompile(`\s(?:id|name)="([^"]*)"`)
+)
+
+// checker caches the ids of the pages already read.
+type checker struct {
+	ids map[string]map[string]bool
+}
+
+// Check reads every HTML file in `docs/` and reports the internal `href` and `src`
+// references, including `#id` fragments, that do not resolve to a file or element.
+func Check() ([]Broken, error) {
+	pages, err := filer.ListSitePages()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &checker{ids: make(map[string]map[string]bool)}
+	broken := []Broken{}
+
+	for _, page := range pages {
+		file := filer.LocalPath(page)
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range linkAttr.FindAllStringSubmatchIndex(string(content), -1) {
+			ref := string(content[m[2]:m[3]])
+			msg, err := c.resolve(page, html.UnescapeString(ref))
+			if err != nil {
+				return nil, err
+			}
+			if msg == "" {
+				continue
+			}
+
+			broken = append(broken, Broken{
+				File: file,
+				Line: strings.Count(string(content[:m[0]]), "\n") + 1,
+				Ref:  ref,
+				Msg:  msg,
+			})
+		}
+	}
+
+	return broken, nil
+}
+
+// resolve returns why the reference `ref` in `page` is broken,
+// or an empty string when it resolves or is external.
+func (c *checker) resolve(page string, ref string) (string, error) {
+	if site.BaseURL != "" && strings.HasPrefix(ref, site.BaseURL+"/") {
+		ref = strings.TrimPrefix(ref, site.BaseURL)
+	}
+
+	u, err := url.Parse(ref)
+	if err != nil {
+		return "invalid link", nil
+	}
+	if u.Scheme != "" || u.Host != "" || strings.HasPrefix(ref, "//") {
+		return "", nil
+	}
+
+	target := page
+	if u.Path != "" {
+		target = u.Path
+		if !strings.HasPrefix(target, "/") {
+			target = path.Join(path.Dir(page), target)
+		}
+		target = find(target)
+		if target == "" {
+			if strings.HasPrefix(u.Path, "/assets/logos/") {
+				return "missing tag logo", nil
+			}
+			return "missing file", nil
+		}
+	}
+
+	if u.Fragment == "" || !strings.HasSuffix(target, ".html") {
+		return "", nil
+	}
+
+	ids, err := c.pageIDs(target)
+	if err != nil {
+		return "", err
+	}
+	if !ids[u.Fragment] {
+		return "missing anchor", nil
+	}
+	return "", nil
+}
+
+// find returns the file served at the URL path `p`, trying `.html`
+// and `index.html` like the web server does, or an empty string.
+func find(p string) string {
+	candidates := []string{p, p + ".html", path.Join(p, "index.html")}
+	if strings.HasSuffix(p, "/") {
+		candidates = []string{path.Join(p, "index.html")}
+	}
+
+	for _, candidate := range candidates {
+		info, err := os.Stat(filer.LocalPath(candidate))
+		if err == nil && !info.IsDir() {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// pageIDs returns the `id` and `name` attributes of the page at the URL path `p`.
+func (c *checker) pageIDs(p string) (map[string]bool, error) {
+	if ids, ok := c.ids[p]; ok {
+		return ids, nil
+	}
+
+	content, err := os.ReadFile(filer.LocalPath(p))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]bool)
+	for _, m := range idAttr.FindAllStringSubmatch(string(content), -1) {
+		ids[html.UnescapeString(m[1])] = true
+	}
+	c.ids[p] = ids
+	return ids, nil
+}
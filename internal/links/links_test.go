@@ -0,0 +1,71 @@
+package links
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"germandv.xyz/internal/testsite"
+)
+
+func TestMain(m *testing.M) {
+	testsite.Main(m, "../../")
+}
+
+func writeFile(t *testing.T, file string, content string) {
+	t.Helper()
+
+	err := os.MkdirAll(filepath.Dir(file), 0755)
+	if err != nil {
+		t.Fatalf("Error creating dir: %s", err)
+	}
+	err = os.WriteFile(file, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Error writing %s: %s", file, err)
+	}
+	t.Cleanup(func() { os.Remove(file) })
+}
+
+func TestCheck(t *testing.T) {
+	writeFile(t, "testdata/docs/index.html", `<a href="/blog.html">Blog</a>`)
+	writeFile(t, "testdata/docs/blog.html", `<h1 id="top">Blog</h1>
+<a href="/blog/post.html#intro">ok</a>
+<a href="https://germandv.me/blog/post.html">ok, same site</a>
+<a href="https://example.com/missing.html">ok, external</a>
+<a href="mailto:me@example.com">ok, mail</a>
+<a href="/">ok, home</a>
+<a href="#top">ok, same page</a>
+<a href="#bottom">missing anchor</a>
+<a href="/blog/post.html#outro">missing anchor</a>
+<a href="https://germandv.me/blog/gone.html">missing file</a>
+<img src="/assets/logos/cobol.png" />`)
+	writeFile(t, "testdata/docs/blog/post.html", `<h2 id="intro">Intro</h2>
+<a href="data/points.txt">relative</a>
+<a href="post/data.txt">missing relative</a>`)
+	writeFile(t, "testdata/docs/blog/data/points.txt", "1,2\n")
+	t.Cleanup(func() {
+		os.Remove("testdata/docs/blog/data")
+		os.RemoveAll("testdata/docs/assets")
+	})
+
+	broken, err := Check()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	want := []Broken{
+		{File: "testdata/docs/blog/post.html", Line: 3, Ref: "post/data.txt", Msg: "missing file"},
+		{File: "testdata/docs/blog.html", Line: 8, Ref: "#bottom", Msg: "missing anchor"},
+		{File: "testdata/docs/blog.html", Line: 9, Ref: "/blog/post.html#outro", Msg: "missing anchor"},
+		{File: "testdata/docs/blog.html", Line: 10, Ref: "https://germandv.me/blog/gone.html", Msg: "missing file"},
+		{File: "testdata/docs/blog.html", Line: 11, Ref: "/assets/logos/cobol.png", Msg: "missing tag logo"},
+	}
+	if len(broken) != len(want) {
+		t.Fatalf("want %d broken links, got %d: %v", len(want), len(broken), broken)
+	}
+	for i := range want {
+		if broken[i] != want[i] {
+			t.Errorf("want %v, got %v", want[i], broken[i])
+		}
+	}
+}
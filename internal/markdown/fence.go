@@ -0,0 +1,25 @@
+// Package markdown scans the markdown of entries without rendering it,
+// for the tools that work on the source, like shortcodes and the code verifier.
+package markdown
+
+import "strings"
+
+// Fence returns the ``` or ~~~ run opening a fenced code block on `line`, if any.
+// Leading spaces are ignored, so fences nested in lists are found too.
+func Fence(line string) string {
+	line = strings.TrimLeft(line, " ")
+	for _, c := range []string{"`", "~"} {
+		n := len(line) - len(strings.TrimLeft(line, c))
+		if n >= 3 {
+			return strings.Repeat(c, n)
+		}
+	}
+	return ""
+}
+
+// ClosesFence reports whether `line` closes the code block opened by `fence`,
+// a run of the same character at least as long, with nothing after it.
+func ClosesFence(line string, fence string) bool {
+	line = strings.TrimSpace(line)
+	return strings.HasPrefix(line, fence) && strings.Trim(line, fence[:1]) == ""
+}
@@ -0,0 +1,46 @@
+package markdown
+
+import "testing"
+
+func TestFence(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line  string
+		fence string
+	}{
+		{"```go", "```"},
+		{"  ````md", "````"},
+		{"~~~", "~~~"},
+		{"``", ""},
+		{"text ```", ""},
+	}
+
+	for _, tt := range tests {
+		if got := Fence(tt.line); got != tt.fence {
+			t.Errorf("%q: want %q, got %q", tt.line, tt.fence, got)
+		}
+	}
+}
+
+func TestClosesFence(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line   string
+		fence  string
+		closes bool
+	}{
+		{"```", "```", true},
+		{"  ````  ", "```", true},
+		{"```", "````", false},
+		{"~~~", "```", false},
+		{"```go", "```", false},
+	}
+
+	for _, tt := range tests {
+		if got := ClosesFence(tt.line, tt.fence); got != tt.closes {
+			t.Errorf("%q closing %q: want %v, got %v", tt.line, tt.fence, tt.closes, got)
+		}
+	}
+}
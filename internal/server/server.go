@@ -5,20 +5,29 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"germandv.xyz/internal/config"
 	"germandv.xyz/internal/editor"
+	"germandv.xyz/internal/feed"
 	"germandv.xyz/internal/filer"
+	"germandv.xyz/internal/sitemap"
 )
 
+// publishInterval is how often the server looks for scheduled drafts that are due.
+const publishInterval = time.Minute
+
 type Server struct {
-	mux    *http.ServeMux
-	server *http.Server
-	port   int
+	mux         *http.ServeMux
+	server      *http.Server
+	port        int
+	site        *config.Site
+	feedOptions feed.Options
 }
 
-func New(port int) *Server {
+func New(port int, site *config.Site, feedOptions feed.Options) *Server {
 	mux := &http.ServeMux{}
 
 	server := &http.Server{
@@ -30,9 +39,11 @@ func New(port int) *Server {
 	}
 
 	return &Server{
-		server: server,
-		mux:    mux,
-		port:   port,
+		server:      server,
+		mux:         mux,
+		port:        port,
+		site:        site,
+		feedOptions: feedOptions,
 	}
 }
 
@@ -45,6 +56,8 @@ func (s *Server) Listen() {
 		s.registerPreviewHandler()
 	}
 
+	go s.publishScheduled(publishInterval)
+
 	log.Printf("Server up on :%d\n", s.port)
 	err := s.server.ListenAndServe()
 	if err != nil {
@@ -53,7 +66,7 @@ func (s *Server) Listen() {
 }
 
 func (s *Server) registerStaticHandler() {
-	fs := http.FileServer(http.Dir("./docs"))
+	fs := http.FileServer(http.Dir(s.site.DocsDir))
 	fsWithTimeout := http.TimeoutHandler(fs, 5*time.Second, "Timeout\n")
 	s.mux.Handle("/", fsWithTimeout)
 }
@@ -93,6 +106,17 @@ func (s *Server) registerPreviewHandler() {
 			return
 		}
 
+		// Assets of the draft, e.g. the images of a page bundle, linked relative to the .md file.
+		if !strings.HasSuffix(file, ".md") {
+			rel, err := filepath.Rel(s.site.EntriesDir, filepath.FromSlash(file))
+			if err != nil || strings.HasPrefix(rel, "..") {
+				http.NotFound(w, r)
+				return
+			}
+			http.ServeFile(w, r, file)
+			return
+		}
+
 		tmpl, entry, err := editor.Preview(file)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -104,3 +128,47 @@ func (s *Server) registerPreviewHandler() {
 
 	s.mux.Handle("/preview/", http.HandlerFunc(handler))
 }
+
+// publishScheduled periodically publishes the drafts that are due,
+// regenerating the index, the feeds and the sitemap when anything was published.
+func (s *Server) publishScheduled(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// The errors are logged when they change, not every time the same drafts are skipped.
+	reported := ""
+	for now := range ticker.C {
+		published, err := editor.PublishDue(now)
+		msg := ""
+		if err != nil {
+			msg = err.Error()
+		}
+		if msg != "" && msg != reported {
+			log.Printf("Error publishing scheduled entries: %s\n", msg)
+		}
+		reported = msg
+		if len(published) == 0 {
+			continue
+		}
+
+		err = editor.GenerateIndex()
+		if err != nil {
+			log.Printf("Error generating blog.html: %s\n", err)
+			continue
+		}
+		err = feed.Generate(s.feedOptions)
+		if err != nil {
+			log.Printf("Error generating rss feed: %s\n", err)
+			continue
+		}
+		err = sitemap.Generate()
+		if err != nil {
+			log.Printf("Error generating sitemap: %s\n", err)
+			continue
+		}
+
+		for _, p := range published {
+			log.Printf("Scheduled entry %q published\n", p)
+		}
+	}
+}
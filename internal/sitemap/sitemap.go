@@ -0,0 +1,91 @@
+package sitemap
+
+import (
+	"sort"
+	"time"
+
+	"germandv.xyz/internal/config"
+	"germandv.xyz/internal/editor"
+	"germandv.xyz/internal/entry"
+	"germandv.xyz/internal/filer"
+)
+
+type URL struct {
+	Loc     string
+	LastMod string // YYYY-MM-DD, empty if unknown
+}
+
+// site provides the base URL of the sitemap.
+var site = config.Default()
+
+// Configure sets the site configuration used to generate the sitemap.
+func Configure(s *config.Site) {
+	site = s
+}
+
+// Generate creates a `sitemap.xml` listing the home page, the index,
+// every published entry and every tag page, plus a `robots.txt` pointing to it.
+func Generate() error {
+	files, err := filer.ListPublished()
+	if err != nil {
+		return err
+	}
+
+	pages := []URL{}
+	var newest time.Time
+	tags := make(map[string]time.Time)
+
+	for _, file := range files {
+		frontMatter, _, err := editor.ParseMd(file)
+		if err != nil {
+			return err
+		}
+
+		art, err := entry.NewHtmlEntry(frontMatter)
+		if err != nil {
+			return err
+		}
+
+		revision, err := time.Parse(entry.InputDateFormat, frontMatter.Revision)
+		if err != nil {
+			return err
+		}
+		if revision.After(newest) {
+			newest = revision
+		}
+		for _, tag := range art.Tags {
+			if revision.After(tags[tag]) {
+				tags[tag] = revision
+			}
+		}
+
+		pages = append(pages, URL{
+			Loc:     site.PostURL(art.Filename),
+			LastMod: frontMatter.Revision,
+		})
+	}
+
+	for tag, revision := range tags {
+		pages = append(pages, URL{
+			Loc:     site.URL("/tags/" + tag + ".html"),
+			LastMod: revision.Format(entry.InputDateFormat),
+		})
+	}
+
+	sort.Slice(pages, func(i, j int) bool {
+		return pages[i].Loc < pages[j].Loc
+	})
+
+	index := URL{Loc: site.URL("/blog.html")}
+	if !newest.IsZero() {
+		index.LastMod = newest.Format(entry.InputDateFormat)
+	}
+	urls := append([]URL{{Loc: site.URL("/")}, index}, pages...)
+
+	err = filer.WriteTemplate(urls, "sitemap.xml", "sitemap", filer.CreateSitemap)
+	if err != nil {
+		return err
+	}
+
+	return filer.WriteTemplate(site.URL("/sitemap.xml"), "robots.txt", "robots", filer.CreateRobots)
+}
@@ -0,0 +1,73 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"os"
+	"strings"
+	"testing"
+
+	"germandv.xyz/internal/testsite"
+)
+
+func TestMain(m *testing.M) {
+	testsite.Main(m, "../../")
+}
+
+func TestGenerateListsPagesAndTags(t *testing.T) {
+	published := "testdata/entries/published/tagged.md"
+	content := "---\ntitle: tagged\npublished: 2024-03-01\nrevision: 2024-03-05\ntags: [go]\nexcerpt: Tagged.\n---\n\nBody.\n"
+	err := os.WriteFile(published, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Error writing entry: %s", err)
+	}
+	t.Cleanup(func() {
+		os.Remove(published)
+		os.Remove("testdata/docs/sitemap.xml")
+		os.Remove("testdata/docs/robots.txt")
+	})
+
+	err = Generate()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	raw, err := os.ReadFile("testdata/docs/sitemap.xml")
+	if err != nil {
+		t.Fatalf("Error reading sitemap: %s", err)
+	}
+
+	var sitemap struct {
+		URLs []struct {
+			Loc     string `xml:"loc"`
+			LastMod string `xml:"lastmod"`
+		} `xml:"url"`
+	}
+	err = xml.Unmarshal(raw, &sitemap)
+	if err != nil {
+		t.Fatalf("Invalid sitemap: %s", err)
+	}
+
+	want := []struct{ loc, lastmod string }{
+		{"https://germandv.me/", ""},
+		{"https://germandv.me/blog.html", "2024-03-05"},
+		{"https://germandv.me/blog/tagged.html", "2024-03-05"},
+		{"https://germandv.me/tags/go.html", "2024-03-05"},
+	}
+	if len(sitemap.URLs) != len(want) {
+		t.Fatalf("want %d urls, got %d: %+v", len(want), len(sitemap.URLs), sitemap.URLs)
+	}
+	for i, w := range want {
+		got := sitemap.URLs[i]
+		if got.Loc != w.loc || got.LastMod != w.lastmod {
+			t.Errorf("want %s (%s), got %s (%s)", w.loc, w.lastmod, got.Loc, got.LastMod)
+		}
+	}
+
+	robots, err := os.ReadFile("testdata/docs/robots.txt")
+	if err != nil {
+		t.Fatalf("Error reading robots.txt: %s", err)
+	}
+	if !strings.Contains(string(robots), "Sitemap: https://germandv.me/sitemap.xml") {
+		t.Errorf("Expected robots.txt to point to the sitemap, got %q", robots)
+	}
+}
@@ -0,0 +1,94 @@
+// Package testsite provides an isolated copy of the site for tests that
+// write entries and pages, so packages can run their tests in parallel.
+package testsite
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"germandv.xyz/internal/config"
+	"germandv.xyz/internal/filer"
+)
+
+// Setup copies `testdata/` and links `templates/` from the repository `root`
+// into a temporary directory, changes into it and points the filer to it.
+// It returns a function to remove the temporary directory.
+func Setup(root string) (func(), error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := os.MkdirTemp("", "gdv-test-")
+	if err != nil {
+		return nil, err
+	}
+	cleanup := func() { os.RemoveAll(tmp) }
+
+	err = copyDir(filepath.Join(root, "testdata"), filepath.Join(tmp, "testdata"))
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	err = os.Symlink(filepath.Join(root, "templates"), filepath.Join(tmp, "templates"))
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	err = os.Chdir(tmp)
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	site := config.Default()
+	site.EntriesDir = "testdata/entries"
+	site.DocsDir = "testdata/docs"
+	filer.Configure(site)
+
+	return cleanup, nil
+}
+
+// Main runs the tests of a package on its own copy of the site,
+// avoiding clashes with the tests of other packages running at the same time.
+// Call it from TestMain.
+func Main(m *testing.M, root string) {
+	cleanup, err := Setup(root)
+	if err != nil {
+		fmt.Println("Error setting up test site:", err)
+		os.Exit(1)
+	}
+
+	exitCode := m.Run()
+	cleanup()
+	os.Exit(exitCode)
+}
+
+func copyDir(from, to string) error {
+	return filepath.WalkDir(from, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(from, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(to, rel)
+
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, content, 0644)
+	})
+}
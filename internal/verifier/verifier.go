@@ -0,0 +1,239 @@
+// Package verifier compiles the Go code blocks of entries, to catch broken snippets.
+package verifier
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"germandv.xyz/internal/filer"
+	"germandv.xyz/internal/markdown"
+)
+
+// Block is a fenced ```go code block of an entry.
+type Block struct {
+	File string
+	// Index is the position of the block among the Go blocks of the entry, starting at 1.
+	Index int
+	// Line is where the opening fence is.
+	Line int
+	// Complete is set for blocks marked as complete programs, e.g. ```go complete
+	Complete bool
+	Code     string
+}
+
+// Failure is a code block that does not compile or fails `go vet`.
+type Failure struct {
+	Block  Block
+	Step   string
+	Output string
+	// Skipped is set when the dependencies of the block could not be downloaded,
+	// e.g. without network, so the code itself was not checked.
+	Skipped bool
+}
+
+func (f Failure) String() string {
+	outcome := "failed"
+	if f.Skipped {
+		outcome = "skipped (dependencies unavailable)"
+	}
+	return fmt.Sprintf("%s: go block %d (line %d): %s %s\n%s",
+		f.Block.File, f.Block.Index, f.Block.Line, f.Step, outcome, strings.TrimRight(f.Output, "\n"))
+}
+
+// Run verifies the Go code blocks of all draft and published entries.
+// Blocks with a package clause are verified, or only those marked
+// as complete programs when `markedOnly` is set.
+// It returns the failures, including the skipped blocks, and the number of blocks verified.
+func Run(markedOnly bool) ([]Failure, int, error) {
+	drafts, err := filer.ListDrafts()
+	if err != nil {
+		return nil, 0, err
+	}
+	published, err := filer.ListPublished()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	files := []string{}
+	for _, list := range []map[uint]string{drafts, published} {
+		for _, file := range list {
+			files = append(files, file)
+		}
+	}
+	sort.Strings(files)
+
+	failures := []Failure{}
+	verified := 0
+	for _, file := range files {
+		blocks, err := extractBlocks(file)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, block := range blocks {
+			if !shouldVerify(block, markedOnly) {
+				continue
+			}
+			verified++
+
+			failure, err := verify(block)
+			if err != nil {
+				return nil, 0, err
+			}
+			if failure != nil {
+				failures = append(failures, *failure)
+			}
+		}
+	}
+
+	return failures, verified, nil
+}
+
+var packageClause = regexp.MustCompile(`(?m)^package \w+`)
+
+func shouldVerify(block Block, markedOnly bool) bool {
+	if markedOnly {
+		return block.Complete
+	}
+	return block.Complete || packageClause.MatchString(block.Code)
+}
+
+// extractBlocks returns the ```go fenced code blocks of an entry.
+func extractBlocks(file string) ([]Block, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	blocks := []Block{}
+	var current *Block
+	var fence string
+	var code []string
+	index := 0
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		trimmed := strings.TrimLeft(text, " ")
+
+		if fence == "" {
+			marker := markdown.Fence(trimmed)
+			if marker == "" {
+				continue
+			}
+			fence = marker
+
+			info := strings.Fields(strings.TrimPrefix(trimmed, marker))
+			if len(info) > 0 && (info[0] == "go" || info[0] == "golang") {
+				index++
+				current = &Block{File: file, Index: index, Line: line}
+				for _, attr := range info[1:] {
+					if attr == "complete" {
+						current.Complete = true
+					}
+				}
+				code = []string{}
+			}
+			continue
+		}
+
+		if markdown.ClosesFence(trimmed, fence) {
+			if current != nil {
+				current.Code = strings.Join(code, "\n") + "\n"
+				blocks = append(blocks, *current)
+			}
+			current, fence = nil, ""
+			continue
+		}
+		if current != nil {
+			code = append(code, text)
+		}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+// verify writes the block as the only file of a temporary module
+// and runs `go vet` and `go build` on it with the local toolchain.
+func verify(block Block) (*Failure, error) {
+	dir, err := os.MkdirTemp("", "gdv-verify-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	code := block.Code
+	// First line of the code in the entry, to point to it in the output.
+	offset := block.Line
+	if !packageClause.MatchString(code) {
+		code = "package main\n\n" + code
+		offset -= 2
+	}
+	err = os.WriteFile(filepath.Join(dir, "main.go"), []byte(code), 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := []struct {
+		name string
+		args []string
+	}{
+		{"go mod init", []string{"mod", "init", "snippet"}},
+		{"go mod tidy", []string{"mod", "tidy"}},
+		{"go vet", []string{"vet", "./..."}},
+		{"go build", []string{"build", "-o", filepath.Join(dir, "out"), "./..."}},
+	}
+	for _, step := range steps {
+		cmd := exec.Command("go", step.args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if _, ok := err.(*exec.ExitError); ok {
+			return &Failure{
+				Block:   block,
+				Step:    step.name,
+				Output:  toEntryLines(string(out), block.File, offset),
+				Skipped: step.name == "go mod tidy" && unavailable(string(out)),
+			}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// networkError matches the output of the go tool when modules cannot be downloaded
+// because the network or the proxy is unreachable, or downloads are disabled.
+// Modules that do not exist, e.g. misspelled, are reported by the proxy with 404 or 410.
+var networkError = regexp.MustCompile(`dial tcp|i/o timeout|TLS handshake timeout|connection refused|network is unreachable|disabled by GOPROXY=off|GOFLAGS=-mod=mod`)
+
+// unavailable reports whether `go mod tidy` failed to download the dependencies,
+// rather than because of the code.
+func unavailable(out string) bool {
+	return networkError.MatchString(out)
+}
+
+var sourcePos = regexp.MustCompile(`(?:\./)?main\.go:(\d+)`)
+
+// toEntryLines rewrites the `main.go:<line>` positions in the output of the go tool
+// to the corresponding line of the entry.
+func toEntryLines(out string, file string, offset int) string {
+	return sourcePos.ReplaceAllStringFunc(out, func(pos string) string {
+		var line int
+		fmt.Sscan(sourcePos.FindStringSubmatch(pos)[1], &line)
+		return fmt.Sprintf("%s:%d", file, offset+line)
+	})
+}
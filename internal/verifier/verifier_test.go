@@ -0,0 +1,147 @@
+package verifier
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeEntry(t *testing.T, content string) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "entry.md")
+	err := os.WriteFile(file, []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("Error writing entry: %s", err)
+	}
+	return file
+}
+
+const entry = "---\ntitle: a-title\n---\n\n" +
+	"```go\nfmt.Println(\"fragment\")\n```\n\n" +
+	"```sh\ngo run .\n```\n\n" +
+	"````go complete\nfunc main() {}\n````\n\n" +
+	"```go\npackage main\n\nfunc main() {\n\tx := 1\n}\n```\n"
+
+func TestExtractBlocks(t *testing.T) {
+	t.Parallel()
+
+	file := writeEntry(t, entry)
+	blocks, err := extractBlocks(file)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	want := []Block{
+		{File: file, Index: 1, Line: 5, Code: "fmt.Println(\"fragment\")\n"},
+		{File: file, Index: 2, Line: 13, Complete: true, Code: "func main() {}\n"},
+		{File: file, Index: 3, Line: 17, Code: "package main\n\nfunc main() {\n\tx := 1\n}\n"},
+	}
+	if len(blocks) != len(want) {
+		t.Fatalf("want %d blocks, got %d: %+v", len(want), len(blocks), blocks)
+	}
+	for i := range want {
+		if blocks[i] != want[i] {
+			t.Errorf("want %+v, got %+v", want[i], blocks[i])
+		}
+	}
+
+	selected := []int{}
+	for _, markedOnly := range []bool{false, true} {
+		for _, block := range blocks {
+			if shouldVerify(block, markedOnly) {
+				selected = append(selected, block.Index)
+			}
+		}
+	}
+	if fmt.Sprint(selected) != "[2 3 2]" {
+		t.Errorf("want blocks 2 and 3 selected, then only 2 when marked only, got %v", selected)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	file := writeEntry(t, entry)
+	blocks, err := extractBlocks(file)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	failure, err := verify(blocks[1])
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if failure != nil {
+		t.Errorf("want complete program to compile, got %s", failure)
+	}
+
+	failure, err = verify(blocks[2])
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if failure == nil {
+		t.Fatal("want unused variable to fail, got nil")
+	}
+	if failure.Skipped || failure.Step != "go vet" {
+		t.Errorf("want block failed at go vet, got %s", failure)
+	}
+	if !strings.Contains(failure.Output, file+":21:") {
+		t.Errorf("want output pointing to line 21 of the entry, got %s", failure.Output)
+	}
+}
+
+func TestVerifySkipsUnavailableDependencies(t *testing.T) {
+	// Downloads are disabled, so the result does not depend on the network.
+	t.Setenv("GOPROXY", "off")
+
+	tests := []struct {
+		name    string
+		imports string
+		skipped bool
+	}{
+		{"module cannot be downloaded", "example.invalid/missing", true},
+		{"malformed import path", "bad path/x", false},
+	}
+
+	for _, tt := range tests {
+		file := writeEntry(t, "```go\npackage main\n\nimport _ \""+tt.imports+"\"\n\nfunc main() {}\n```\n")
+		blocks, err := extractBlocks(file)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		failure, err := verify(blocks[0])
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if failure == nil {
+			t.Fatalf("%s: want the block reported, got nil", tt.name)
+		}
+		if failure.Skipped != tt.skipped || failure.Step != "go mod tidy" {
+			t.Errorf("%s: want skipped %t at go mod tidy, got %s", tt.name, tt.skipped, failure)
+		}
+	}
+}
+
+func TestUnavailable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		out  string
+		want bool
+	}{
+		{"example.com/a: module example.com/a: Get \"https://proxy.golang.org/example.com/a/@v/list\": dial tcp: lookup proxy.golang.org: no such host", true},
+		{"example.com/a: cannot find module providing package example.com/a: module lookup disabled by GOPROXY=off", true},
+		{"github.com/gorila/mux: module github.com/gorila/mux: reading https://proxy.golang.org/github.com/gorila/mux/@v/list: 404 Not Found", false},
+		{"bad path/x: malformed import path \"bad path/x\": invalid char ' '", false},
+	}
+
+	for _, tt := range tests {
+		if got := unavailable(tt.out); got != tt.want {
+			t.Errorf("want %t for %q, got %t", tt.want, tt.out, got)
+		}
+	}
+}
@@ -6,11 +6,23 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
+	"germandv.xyz/internal/checker"
+	"germandv.xyz/internal/config"
 	"germandv.xyz/internal/editor"
 	"germandv.xyz/internal/feed"
 	"germandv.xyz/internal/filer"
+	"germandv.xyz/internal/links"
 	"germandv.xyz/internal/server"
+	"germandv.xyz/internal/sitemap"
+	"germandv.xyz/internal/verifier"
+)
+
+var (
+	site            *config.Site
+	feedOptions     feed.Options
+	externalOptions links.ExternalOptions
 )
 
 func main() {
@@ -18,26 +30,85 @@ func main() {
 	publishEverything := flag.Bool("publish-all", false, "Publish all entries")
 	publishDraft := flag.Bool("publish", false, "Choose draft entry to publish")
 	entryToCreate := flag.String("draft", "", "Entry to be created as a draft")
-	rss := flag.Bool("feed", false, "Generate RSS feed")
+	rss := flag.Bool("feed", false, "Generate RSS, Atom and JSON feeds")
+	sitemapOnly := flag.Bool("sitemap", false, "Generate sitemap.xml and robots.txt")
+	rebuild := flag.Bool("build", false, "Re-render all published entries, the index and the feed")
+	lint := flag.Bool("check", false, "Validate the front matter of all entries")
+	publishScheduled := flag.Bool("publish-due", false, "Publish drafts whose publish_at time has passed")
+	verifyCode := flag.Bool("verify-code", false, "Compile and vet the Go code blocks of all entries")
+	verifyMarked := flag.Bool("verify-marked", false, "With -verify-code, only check blocks marked as complete programs")
+	linkCheck := flag.Bool("links", false, "Check the internal links of the generated pages")
+	externalCheck := flag.Bool("external-links", false, "Check the external links of published entries")
+	flag.StringVar(&externalOptions.CacheFile, "links-cache", ".links-cache.json", "File caching the results of -external-links")
+	flag.DurationVar(&externalOptions.MaxAge, "links-max-age", 7*24*time.Hour, "How long cached results of -external-links are reused, broken links are always checked again")
+	flag.IntVar(&externalOptions.Concurrency, "links-concurrency", 8, "Maximum number of requests in flight for -external-links")
+	flag.DurationVar(&externalOptions.HostInterval, "links-host-interval", time.Second, "Minimum time between requests to the same host for -external-links")
+	flag.BoolVar(&externalOptions.Offline, "links-offline", false, "With -external-links, only report cached results")
+	flag.IntVar(&feedOptions.Limit, "feed-limit", 0, "Maximum number of entries in the feeds, 0 for all")
+	flag.BoolVar(&feedOptions.FullContent, "feed-full", false, "Include the full content of entries in the RSS and Atom feeds")
+	configFile := flag.String("config", "site.toml", "Site configuration file")
+	var overrides config.Site
+	flag.StringVar(&overrides.Title, "title", "", "Override the site title")
+	flag.StringVar(&overrides.BaseURL, "base-url", "", "Override the site base URL")
+	flag.StringVar(&overrides.EntriesDir, "entries", "", "Override the entries directory")
+	flag.StringVar(&overrides.DocsDir, "docs", "", "Override the docs directory")
 	flag.Parse()
+
+	loadConfig(*configFile, overrides)
+
 	if *startServer {
 		serve()
 	} else if *publishEverything {
 		publishAll()
 		generateFeed()
+		generateSitemap()
 	} else if *publishDraft {
 		publish()
 		generateFeed()
+		generateSitemap()
 	} else if *entryToCreate != "" {
 		create(*entryToCreate)
 	} else if *rss {
 		generateFeed()
+	} else if *sitemapOnly {
+		generateSitemap()
+	} else if *rebuild {
+		build()
+		generateFeed()
+		generateSitemap()
+		checkLinks()
+	} else if *lint {
+		check()
+	} else if *publishScheduled {
+		if publishDue() {
+			generateFeed()
+			generateSitemap()
+		}
+	} else if *verifyCode {
+		verify(*verifyMarked)
+	} else if *linkCheck {
+		checkLinks()
+	} else if *externalCheck {
+		checkExternalLinks()
 	} else {
 		// By default, start the web server.
 		serve()
 	}
 }
 
+func loadConfig(path string, overrides config.Site) {
+	var err error
+	site, err = config.Load(path)
+	must(err, fmt.Sprintf("Error loading config %q", path))
+	site.Override(overrides)
+
+	filer.Configure(site)
+	editor.Configure(site)
+	feed.Configure(site)
+	sitemap.Configure(site)
+	links.Configure(site)
+}
+
 func serve() {
 	portStr, ok := os.LookupEnv("PORT")
 	if !ok {
@@ -47,13 +118,14 @@ func serve() {
 	if err != nil {
 		panic("PORT is not a number")
 	}
-	s := server.New(port)
+	s := server.New(port, site, feedOptions)
 	s.Listen()
 }
 
 func create(title string) {
-	must(editor.Draft(title), fmt.Sprintf("Error creating draft entry %q\n", title))
-	fmt.Printf("%q created!\n", title+".md")
+	filename, err := editor.Draft(title)
+	must(err, fmt.Sprintf("Error creating draft entry %q\n", title))
+	fmt.Printf("%q created!\n", filename)
 }
 
 func publish() {
@@ -91,15 +163,124 @@ func publish() {
 	fmt.Printf("%q published!\n", entryToPublish)
 }
 
+// publishDue reports whether any draft was published.
+func publishDue() bool {
+	published, err := editor.PublishDue(time.Now())
+	if err != nil {
+		fmt.Println("Some scheduled entries could not be published")
+		fmt.Println(err)
+	}
+
+	if len(published) == 0 {
+		fmt.Println("No drafts are due for publishing")
+		return false
+	}
+
+	must(editor.GenerateIndex(), "Error generating blog.html")
+	for _, p := range published {
+		fmt.Printf("%q published!\n", p)
+	}
+	return true
+}
+
 func publishAll() {
-	must(editor.PublishAll(), "Error publishing all entries")
+	must(editor.PublishAll(time.Now()), "Error publishing all entries")
 	must(editor.GenerateIndex(), "Error generating blog.html")
 	fmt.Println("All entries published!")
 }
 
+func build() {
+	must(editor.Build(), "Error building site")
+	fmt.Println("Site rebuilt!")
+}
+
+func check() {
+	problems, err := checker.Run()
+	must(err, "Error checking entries")
+
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+	if len(problems) > 0 {
+		fmt.Printf("%d problem(s) found\n", len(problems))
+		os.Exit(1)
+	}
+	fmt.Println("All entries look good!")
+}
+
+func verify(markedOnly bool) {
+	failures, verified, err := verifier.Run(markedOnly)
+	must(err, "Error verifying code blocks")
+
+	failed, skipped := 0, 0
+	for _, f := range failures {
+		fmt.Println(f)
+		if f.Skipped {
+			skipped++
+		} else {
+			failed++
+		}
+	}
+	if skipped > 0 {
+		fmt.Printf("%d of %d Go code block(s) skipped, their dependencies are unavailable\n", skipped, verified)
+	}
+	if failed > 0 {
+		fmt.Printf("%d of %d Go code block(s) failed\n", failed, verified)
+		os.Exit(1)
+	}
+	fmt.Printf("All %d checked Go code block(s) compile!\n", verified-skipped)
+}
+
+func checkLinks() {
+	broken, err := links.Check()
+	must(err, "Error checking links")
+
+	for _, b := range broken {
+		fmt.Println(b)
+	}
+	if len(broken) > 0 {
+		fmt.Printf("%d broken link(s) found\n", len(broken))
+		os.Exit(1)
+	}
+	fmt.Println("All internal links resolve!")
+}
+
+func checkExternalLinks() {
+	results, err := links.CheckExternal(externalOptions)
+	must(err, "Error checking external links")
+
+	broken, redirected, unchecked := 0, 0, 0
+	for _, l := range results {
+		switch {
+		case !l.Status.Checked():
+			unchecked++
+		case l.Status.Broken():
+			broken++
+			fmt.Println(l)
+		case l.Status.Redirected():
+			redirected++
+			fmt.Println(l)
+		}
+	}
+
+	fmt.Printf("%d external link(s): %d broken, %d redirected", len(results), broken, redirected)
+	if unchecked > 0 {
+		fmt.Printf(", %d not in the cache", unchecked)
+	}
+	fmt.Println()
+	if broken > 0 {
+		os.Exit(1)
+	}
+}
+
 func generateFeed() {
-	must(feed.Generate(), "Error generating rss feed")
-	fmt.Println("RSS feed generated!")
+	must(feed.Generate(feedOptions), "Error generating feeds")
+	fmt.Println("RSS, Atom and JSON feeds generated!")
+}
+
+func generateSitemap() {
+	must(sitemap.Generate(), "Error generating sitemap")
+	fmt.Println("Sitemap and robots.txt generated!")
 }
 
 func must(err error, msg string) {